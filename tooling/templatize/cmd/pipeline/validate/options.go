@@ -16,10 +16,15 @@ package validate
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
@@ -34,14 +39,24 @@ import (
 	"github.com/Azure/ARO-Tools/pipelines/types"
 
 	"github.com/Azure/ARO-HCP/tooling/templatize/cmd/configuration/validate"
+	"github.com/Azure/ARO-HCP/tooling/templatize/pkg/lint"
 	"github.com/Azure/ARO-HCP/tooling/templatize/pkg/pipeline"
 )
 
+const (
+	OutputFormatText  = "text"
+	OutputFormatSARIF = "sarif"
+	OutputFormatJSON  = "json"
+)
+
 func DefaultValidationOptions() *RawValidationOptions {
 	return &RawValidationOptions{
 		DevMode:          false,
 		DevRegion:        "uksouth",
 		CentralRemoteUrl: []string{"https://github.com/Azure/ARO-HCP.git"},
+		OutputFormat:     OutputFormatText,
+		MaxSteps:         lint.DefaultMaxSteps,
+		MaxSubscriptions: lint.DefaultMaxSubscriptions,
 	}
 }
 
@@ -52,10 +67,22 @@ func BindValidationOptions(opts *RawValidationOptions, cmd *cobra.Command) error
 	cmd.Flags().StringVar(&opts.DevRegion, "dev-region", opts.DevRegion, "Region to use for dev mode validation.")
 	cmd.Flags().BoolVar(&opts.OnlyChanged, "only-changed", opts.OnlyChanged, "Validate only pipelines whose files have uncommitted changes.")
 	cmd.Flags().StringArrayVar(&opts.CentralRemoteUrl, "central-remote-url", opts.CentralRemoteUrl, "Central remote URL for the repository. Can be specified multiple times.")
+	cmd.Flags().StringVar(&opts.OutputFormat, "output-format", opts.OutputFormat, fmt.Sprintf("Format in which to report pipeline validation findings, one of %s, %s or %s.", OutputFormatText, OutputFormatSARIF, OutputFormatJSON))
+	cmd.Flags().StringVar(&opts.OutputFile, "output-file", opts.OutputFile, fmt.Sprintf("Path to write the findings to when using the %s or %s output format.", OutputFormatSARIF, OutputFormatJSON))
+	cmd.Flags().BoolVar(&opts.GroupByResourceGroup, "group-by-resource-group", opts.GroupByResourceGroup, fmt.Sprintf("Group the findings of each pipeline by resource group when using the %s output format.", OutputFormatJSON))
+	cmd.Flags().BoolVar(&opts.RequireRunnableActions, "require-runnable-actions", opts.RequireRunnableActions, "Require every step's action to be executable by templatize, not only by Ev2.")
+	cmd.Flags().StringArrayVar(&opts.DeprecatedCommands, "deprecated-command", opts.DeprecatedCommands, "Warn about Shell commands matching a deprecated invocation, given as <regexp>=<replacement>. The replacement may not contain '='. Can be specified multiple times.")
+	cmd.Flags().BoolVar(&opts.UniqueAKSClusters, "unique-aks-clusters", opts.UniqueAKSClusters, "Require each AKS cluster to be targeted by a single resource group per subscription.")
+	cmd.Flags().IntVar(&opts.MaxSteps, "max-steps", opts.MaxSteps, "Maximum number of steps in a pipeline. Zero disables the limit.")
+	cmd.Flags().BoolVar(&opts.OutputsDeclaredFirst, "outputs-declared-first", opts.OutputsDeclaredFirst, "Require steps producing outputs to be declared before the steps consuming them.")
+	cmd.Flags().IntVar(&opts.MaxSubscriptions, "max-subscriptions", opts.MaxSubscriptions, "Number of distinct subscriptions in a pipeline above which a warning is raised. Zero disables the warning.")
+	cmd.Flags().StringArrayVar(&opts.AllowedPaths, "allowed-path", opts.AllowedPaths, "Directory that files referenced by steps must reside in. Can be specified multiple times. All paths are allowed when unset.")
 
 	for _, flag := range []string{
 		"service-config-file",
 		"topology-config-file",
+		"output-file",
+		"allowed-path",
 	} {
 		if err := cmd.MarkFlagFilename(flag); err != nil {
 			return fmt.Errorf("failed to mark flag %q as a file: %w", flag, err)
@@ -72,6 +99,17 @@ type RawValidationOptions struct {
 	DevRegion         string
 	OnlyChanged       bool
 	CentralRemoteUrl  []string
+
+	OutputFormat           string
+	OutputFile             string
+	GroupByResourceGroup   bool
+	RequireRunnableActions bool
+	DeprecatedCommands     []string
+	UniqueAKSClusters      bool
+	MaxSteps               int
+	OutputsDeclaredFirst   bool
+	MaxSubscriptions       int
+	AllowedPaths           []string
 }
 
 // validatedValidationOptions is a private wrapper that enforces a call of Validate() before Complete() can be invoked.
@@ -86,13 +124,18 @@ type ValidatedValidationOptions struct {
 
 // completedValidationOptions is a private wrapper that enforces a call of Complete() before config generation can be invoked.
 type completedValidationOptions struct {
-	TopologyFiles    []string
-	Topology         *topology.CombinedTopology
-	Config           config.ConfigProvider
-	DevMode          bool
-	DevRegion        string
-	OnlyChanged      bool
-	CentralRemoteUrl []string
+	TopologyFiles        []string
+	Topology             *topology.CombinedTopology
+	Config               config.ConfigProvider
+	DevMode              bool
+	DevRegion            string
+	OnlyChanged          bool
+	CentralRemoteUrl     []string
+	OutputFormat         string
+	OutputFile           string
+	GroupByResourceGroup bool
+	LintOptions          *lint.Options
+	AllowedPaths         []string
 }
 
 type ValidationOptions struct {
@@ -118,6 +161,20 @@ func (o *RawValidationOptions) Validate() (*ValidatedValidationOptions, error) {
 	if len(o.TopologyFiles) == 0 {
 		return nil, fmt.Errorf("the topology configuration file must be provided with --topology-config-file")
 	}
+	if o.OutputFormat != OutputFormatText && o.OutputFormat != OutputFormatSARIF && o.OutputFormat != OutputFormatJSON {
+		return nil, fmt.Errorf("the output format must be %s, %s or %s, got %q", OutputFormatText, OutputFormatSARIF, OutputFormatJSON, o.OutputFormat)
+	}
+	if o.OutputFormat != OutputFormatText && o.OutputFile == "" {
+		return nil, fmt.Errorf("the output file must be provided with --output-file when using the %s output format", o.OutputFormat)
+	}
+	if o.GroupByResourceGroup && o.OutputFormat != OutputFormatJSON {
+		return nil, fmt.Errorf("findings can only be grouped by resource group when using the %s output format", OutputFormatJSON)
+	}
+	for _, deprecated := range o.DeprecatedCommands {
+		if strings.LastIndex(deprecated, "=") <= 0 {
+			return nil, fmt.Errorf("deprecated commands must be provided as <regexp>=<replacement>, got %q", deprecated)
+		}
+	}
 
 	return &ValidatedValidationOptions{
 		validatedValidationOptions: &validatedValidationOptions{
@@ -140,15 +197,47 @@ func (o *ValidatedValidationOptions) Complete() (*ValidationOptions, error) {
 		return nil, fmt.Errorf("failed to load config file: %w", err)
 	}
 
+	lintOptions := lint.DefaultOptions()
+	if o.RequireRunnableActions {
+		lintOptions.Actions = lint.DefaultActionRegistry()
+	}
+	for _, deprecated := range o.DeprecatedCommands {
+		// the pattern may contain '=', so split at the last one
+		i := strings.LastIndex(deprecated, "=")
+		pattern, replacement := deprecated[:i], deprecated[i+1:]
+		compiled, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile deprecated command %q: %w", pattern, err)
+		}
+		lintOptions.DeprecatedCommands = append(lintOptions.DeprecatedCommands, lint.DeprecatedCommand{Pattern: compiled, Replacement: replacement})
+	}
+	lintOptions.UniqueAKSClusters = o.UniqueAKSClusters
+	lintOptions.MaxSteps = o.MaxSteps
+	lintOptions.OutputsDeclaredFirst = o.OutputsDeclaredFirst
+	lintOptions.MaxSubscriptions = o.MaxSubscriptions
+	var allowedPaths []string
+	for _, path := range o.AllowedPaths {
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to determine absolute path of allowed path %s: %w", path, err)
+		}
+		allowedPaths = append(allowedPaths, absPath)
+	}
+
 	return &ValidationOptions{
 		completedValidationOptions: &completedValidationOptions{
-			TopologyFiles:    o.TopologyFiles,
-			Topology:         t,
-			Config:           c,
-			DevMode:          o.DevMode,
-			DevRegion:        o.DevRegion,
-			OnlyChanged:      o.OnlyChanged,
-			CentralRemoteUrl: o.CentralRemoteUrl,
+			TopologyFiles:        o.TopologyFiles,
+			Topology:             t,
+			Config:               c,
+			DevMode:              o.DevMode,
+			DevRegion:            o.DevRegion,
+			OnlyChanged:          o.OnlyChanged,
+			CentralRemoteUrl:     o.CentralRemoteUrl,
+			OutputFormat:         o.OutputFormat,
+			OutputFile:           o.OutputFile,
+			GroupByResourceGroup: o.GroupByResourceGroup,
+			LintOptions:          lintOptions,
+			AllowedPaths:         allowedPaths,
 		},
 	}, nil
 }
@@ -175,6 +264,7 @@ func (opts *ValidationOptions) ValidatePipelineConfigReferences(ctx context.Cont
 	if err != nil {
 		return fmt.Errorf("failed to load ev2 contexts: %w", err)
 	}
+	linter := newPipelineLinter(opts.LintOptions, opts.AllowedPaths)
 	group, _ := errgroup.WithContext(ctx)
 	for cloud, environments := range opts.Config.AllContexts() {
 		cloudLogger := logger.WithValues("cloud", cloud)
@@ -236,17 +326,20 @@ func (opts *ValidationOptions) ValidatePipelineConfigReferences(ctx context.Cont
 				}
 
 				for _, service := range opts.Topology.Services {
-					if err := handleService(regionLogger, prefix, group, opts.Topology, service, cfg, shouldHandleService); err != nil {
+					if err := handleService(regionLogger, prefix, group, opts.Topology, service, cfg, shouldHandleService, linter); err != nil {
 						return err
 					}
 				}
 			}
 		}
 	}
-	return group.Wait()
+	if err := group.Wait(); err != nil {
+		return err
+	}
+	return linter.report(logger, opts.OutputFormat, opts.OutputFile, opts.GroupByResourceGroup)
 }
 
-func handleService(logger logr.Logger, context string, group *errgroup.Group, t *topology.CombinedTopology, service topology.Service, cfg configtypes.Configuration, shouldHandleService func(string) bool) error {
+func handleService(logger logr.Logger, context string, group *errgroup.Group, t *topology.CombinedTopology, service topology.Service, cfg configtypes.Configuration, shouldHandleService func(string) bool, linter *pipelineLinter) error {
 	group.Go(func() error {
 		if !shouldHandleService(service.ServiceGroup) {
 			return nil
@@ -255,10 +348,14 @@ func handleService(logger logr.Logger, context string, group *errgroup.Group, t
 		if err != nil {
 			return fmt.Errorf("%s: %s: failed to get topology dir: %w", context, service.ServiceGroup, err)
 		}
-		pipeline, err := types.NewPipelineFromFile(filepath.Join(baseDir, service.PipelinePath), cfg)
+		pipelineFile := filepath.Join(baseDir, service.PipelinePath)
+		pipeline, err := types.NewPipelineFromFile(pipelineFile, cfg)
 		if err != nil {
 			return fmt.Errorf("%s: %s: failed to parse pipeline %s: %w", context, service.ServiceGroup, service.PipelinePath, err)
 		}
+		if err := linter.lint(pipelineFile, pipeline); err != nil {
+			return fmt.Errorf("%s: %s: failed to lint pipeline %s: %w", context, service.ServiceGroup, service.PipelinePath, err)
+		}
 
 		type variableRef struct {
 			variable types.Value
@@ -267,172 +364,14 @@ func handleService(logger logr.Logger, context string, group *errgroup.Group, t
 		var variables []variableRef
 		for i, rg := range pipeline.ResourceGroups {
 			for j, step := range rg.Steps {
-				switch step.ActionType() {
-				case "Shell":
-					specificStep, ok := step.(*types.ShellStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.ShellStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					for k, variable := range specificStep.Variables {
-						variables = append(variables, variableRef{
-							variable: variable.Value,
-							ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].variables[%d]", i, j, k),
-						})
-					}
-					variables = append(variables, variableRef{
-						variable: specificStep.ShellIdentity,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].shellIdentity", i, j),
-					})
-				case "ARM":
-					specificStep, ok := step.(*types.ARMStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.ARMStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					for k, variable := range specificStep.Variables {
-						variables = append(variables, variableRef{
-							variable: variable.Value,
-							ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].variables[%d]", i, j, k),
-						})
-					}
-				case "DelegateChildZone":
-					specificStep, ok := step.(*types.DelegateChildZoneStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.DelegateChildZoneStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					variables = append(variables, variableRef{
-						variable: specificStep.ParentZone,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].parentZone", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.ChildZone,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].childZone", i, j),
-					})
-				case "SetCertificateIssuer":
-					specificStep, ok := step.(*types.SetCertificateIssuerStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.SetCertificateIssuerStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					variables = append(variables, variableRef{
-						variable: specificStep.VaultBaseUrl,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].vaultBaseUrl", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.Issuer,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].issuer", i, j),
-					})
-				case "CreateCertificate":
-					specificStep, ok := step.(*types.CreateCertificateStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.CreateCertificateStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					variables = append(variables, variableRef{
-						variable: specificStep.VaultBaseUrl,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].vaultBaseUrl", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.CertificateName,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].certificateName", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.ContentType,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].contentType", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.SAN,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].san", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.Issuer,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].issuer", i, j),
-					})
-				case "ResourceProviderRegistration":
-					specificStep, ok := step.(*types.ResourceProviderRegistrationStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.ResourceProviderRegistrationStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					variables = append(variables, variableRef{
-						variable: specificStep.ResourceProviderNamespaces,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].resourceProviderNamespaces", i, j),
-					})
-				case "ImageMirror":
-					specificStep, ok := step.(*types.ImageMirrorStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.ImageMirrorStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					variables = append(variables, variableRef{
-						variable: specificStep.TargetACR,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].targetACR", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.SourceRegistry,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].sourceRegistry", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.Repository,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].repository", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.Digest,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].digest", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.PullSecretKeyVault,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].pullSecretKeyVault", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.PullSecretName,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].pullSecretName", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.ShellIdentity,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].shellIdentity", i, j),
-					})
-				case "RPLogsAccount", "ClusterLogsAccount":
-					specificStep, ok := step.(*types.LogsStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.LogsStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					variables = append(variables, variableRef{
-						variable: specificStep.SubscriptionId,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].subscriptionId", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.Namespace,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].namespace", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.CertSAN,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].certsan", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.CertDescription,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].certdescription", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: specificStep.ConfigVersion,
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].configVersion", i, j),
-					})
-				case "ProviderFeatureRegistration":
-					specificStep, ok := step.(*types.ProviderFeatureRegistrationStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.ProviderFeatureRegistrationStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
-					variables = append(variables, variableRef{
-						variable: types.Value{ConfigRef: specificStep.ProviderConfigRef},
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].providerConfigRef", i, j),
-					})
-					variables = append(variables, variableRef{
-						variable: types.Value{Input: &specificStep.IdentityFrom},
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].identityFrom", i, j),
-					})
-				case "SecretSync":
-					specificStep, ok := step.(*types.SecretSyncStep)
-					if !ok {
-						return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: have action %q, expected *types.SecretSyncStep, but got %T", service.ServiceGroup, i, j, step.ActionType(), step)
-					}
+				values, err := lint.StepValues(step)
+				if err != nil {
+					return fmt.Errorf("%s: resourceGroups[%d].steps[%d]: %w", service.ServiceGroup, i, j, err)
+				}
+				for _, value := range values {
 					variables = append(variables, variableRef{
-						variable: types.Value{Input: &specificStep.IdentityFrom},
-						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].identityFrom", i, j),
+						variable: value.Value,
+						ref:      fmt.Sprintf("resourceGroups[%d].steps[%d].%s", i, j, value.Field),
 					})
 				}
 			}
@@ -443,7 +382,7 @@ func handleService(logger logr.Logger, context string, group *errgroup.Group, t
 					return fmt.Errorf("%s: %s: %s: configRef %q not present in configuration: %w", context, service.ServiceGroup, variable.ref, variable.variable.ConfigRef, err)
 				}
 			}
-			if variable.variable.Value == "" && variable.variable.ConfigRef == "" && variable.variable.Input.Name == "" && variable.variable.Input.Step == "" {
+			if variable.variable.Value == "" && variable.variable.ConfigRef == "" && (variable.variable.Input == nil || variable.variable.Input.Name == "" && variable.variable.Input.Step == "") {
 				return fmt.Errorf("%s: %s: %s: variable is empty", context, service.ServiceGroup, variable.ref)
 			}
 		}
@@ -451,13 +390,117 @@ func handleService(logger logr.Logger, context string, group *errgroup.Group, t
 		return nil
 	})
 	for _, child := range service.Children {
-		if err := handleService(logger, context, group, t, child, cfg, shouldHandleService); err != nil {
+		if err := handleService(logger, context, group, t, child, cfg, shouldHandleService, linter); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// pipelineLinter lints pipelines and collects the findings for each pipeline file. The same
+// pipeline is linted once for every region it is validated in, so findings are deduplicated.
+type pipelineLinter struct {
+	options      *lint.Options
+	allowedPaths []string
+
+	lock      sync.Mutex
+	seen      sets.Set[pipelineFinding]
+	pipelines map[string]*types.Pipeline
+	findings  map[string][]lint.Finding
+}
+
+type pipelineFinding struct {
+	pipelineFile string
+	finding      string
+}
+
+func newPipelineLinter(options *lint.Options, allowedPaths []string) *pipelineLinter {
+	return &pipelineLinter{
+		options:      options,
+		allowedPaths: allowedPaths,
+		seen:         sets.New[pipelineFinding](),
+		pipelines:    map[string]*types.Pipeline{},
+		findings:     map[string][]lint.Finding{},
+	}
+}
+
+func (l *pipelineLinter) lint(pipelineFile string, p *types.Pipeline) error {
+	options := *l.options
+	if len(l.allowedPaths) > 0 {
+		pipelineDir, err := filepath.Abs(filepath.Dir(pipelineFile))
+		if err != nil {
+			return fmt.Errorf("failed to determine absolute path of pipeline directory: %w", err)
+		}
+		options.PathPolicy = &lint.PathPolicy{PipelineDirectory: pipelineDir, AllowedPaths: l.allowedPaths}
+	}
+	findings := lint.Lint(p, &options)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if _, ok := l.pipelines[pipelineFile]; !ok {
+		l.pipelines[pipelineFile] = p
+	}
+	for _, finding := range findings {
+		key := pipelineFinding{pipelineFile: pipelineFile, finding: finding.String()}
+		if l.seen.Has(key) {
+			continue
+		}
+		l.seen.Insert(key)
+		l.findings[pipelineFile] = append(l.findings[pipelineFile], finding)
+	}
+	return nil
+}
+
+// report writes the collected findings in the requested format and returns an error if any of them is an error.
+func (l *pipelineLinter) report(logger logr.Logger, format, outputFile string, groupByResourceGroup bool) error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	var errs []error
+	for _, pipelineFile := range sets.List(sets.KeySet(l.findings)) {
+		for _, finding := range l.findings[pipelineFile] {
+			if finding.Severity == lint.SeverityError {
+				errs = append(errs, fmt.Errorf("%s: %s", pipelineFile, finding))
+				continue
+			}
+			if format == OutputFormatText {
+				logger.Info("Pipeline validation finding.", "pipeline", pipelineFile, "finding", finding.String())
+			}
+		}
+	}
+
+	var document []byte
+	switch format {
+	case OutputFormatSARIF:
+		var err error
+		document, err = lint.SARIF(l.findings)
+		if err != nil {
+			return fmt.Errorf("failed to render findings as SARIF: %w", err)
+		}
+	case OutputFormatJSON:
+		var report any = l.findings
+		if groupByResourceGroup {
+			grouped := map[string][]lint.FindingGroup{}
+			for pipelineFile, findings := range l.findings {
+				grouped[pipelineFile] = lint.GroupByResourceGroup(l.pipelines[pipelineFile], findings)
+			}
+			report = grouped
+		}
+		var err error
+		document, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to render findings as JSON: %w", err)
+		}
+	}
+	if document != nil {
+		if err := os.WriteFile(outputFile, document, 0644); err != nil {
+			return fmt.Errorf("failed to write findings to %s: %w", outputFile, err)
+		}
+		logger.Info("Wrote pipeline validation findings.", "file", outputFile)
+	}
+	return errors.Join(errs...)
+}
+
 // DetermineChangedServices uses `git diff` output to try to guess which services have changes in the working tree.
 // It takes a single topology directory and a *topology.Topology. For multi-topology-file support, use
 // DetermineChangedServicesCombined instead.
@@ -0,0 +1,78 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+
+	"github.com/Azure/ARO-HCP/tooling/templatize/pkg/pipeline"
+)
+
+// ActionRegistry records the step actions an executor implements.
+type ActionRegistry struct {
+	actions sets.Set[string]
+}
+
+// NewActionRegistry returns a registry holding the given actions.
+func NewActionRegistry(actions ...string) *ActionRegistry {
+	return &ActionRegistry{actions: sets.New[string](actions...)}
+}
+
+// DefaultActionRegistry returns a registry holding the actions executed by pipeline.RunStep.
+// Actions that are only implemented by Ev2 are not included.
+func DefaultActionRegistry() *ActionRegistry {
+	return NewActionRegistry(pipeline.RunnableActions()...)
+}
+
+// Register adds actions to the registry.
+func (r *ActionRegistry) Register(actions ...string) {
+	r.actions.Insert(actions...)
+}
+
+// Has determines if an executor is registered for the action.
+func (r *ActionRegistry) Has(action string) bool {
+	return r.actions.Has(action)
+}
+
+// Actions lists the registered actions in sorted order.
+func (r *ActionRegistry) Actions() []string {
+	return sets.List(r.actions)
+}
+
+func checkActions(p *types.Pipeline, opts *Options) []Finding {
+	if opts.Actions == nil {
+		return nil
+	}
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			if !opts.Actions.Has(step.ActionType()) {
+				findings = append(findings, Finding{
+					Path:          stepPath(i, j),
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("no executor registered for action %q", step.ActionType()),
+					Severity:      SeverityError,
+					RuleID:        "registered-action",
+				})
+			}
+		}
+	}
+	return findings
+}
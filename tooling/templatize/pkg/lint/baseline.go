@@ -0,0 +1,103 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// BaselinePolicy determines which changes from an approved baseline are reported as sensitive.
+type BaselinePolicy struct {
+	// NewSubscriptions reports subscriptions that the baseline did not deploy to.
+	NewSubscriptions bool
+	// NewActions reports step actions that the baseline did not use.
+	NewActions bool
+	// RemovedSteps reports steps in the baseline that no longer exist.
+	RemovedSteps bool
+}
+
+// DefaultBaselinePolicy reports every kind of sensitive change.
+func DefaultBaselinePolicy() *BaselinePolicy {
+	return &BaselinePolicy{
+		NewSubscriptions: true,
+		NewActions:       true,
+		RemovedSteps:     true,
+	}
+}
+
+// ValidateAgainstBaseline reports the changes from baseline to current that the policy considers sensitive.
+func ValidateAgainstBaseline(current, baseline *types.Pipeline, policy *BaselinePolicy) []Finding {
+	if policy == nil {
+		policy = DefaultBaselinePolicy()
+	}
+	baselineSubscriptions := sets.New[string]()
+	baselineActions := sets.New[string]()
+	for _, rg := range baseline.ResourceGroups {
+		baselineSubscriptions.Insert(rg.Subscription)
+		for _, step := range rg.Steps {
+			baselineActions.Insert(step.ActionType())
+		}
+	}
+
+	var findings []Finding
+	reportedSubscriptions := sets.New[string]()
+	reportedActions := sets.New[string]()
+	currentSteps := sets.New[types.StepDependency]()
+	for i, rg := range current.ResourceGroups {
+		if policy.NewSubscriptions && !baselineSubscriptions.Has(rg.Subscription) && !reportedSubscriptions.Has(rg.Subscription) {
+			reportedSubscriptions.Insert(rg.Subscription)
+			findings = append(findings, Finding{
+				Path:          resourceGroupPath(i),
+				ResourceGroup: ptr.To(i),
+				Message:       fmt.Sprintf("subscription %q is not deployed to by the baseline", rg.Subscription),
+				Severity:      SeverityWarning,
+				RuleID:        "baseline-new-subscription",
+			})
+		}
+		for j, step := range rg.Steps {
+			currentSteps.Insert(types.StepDependency{ResourceGroup: rg.Name, Step: step.StepName()})
+			if policy.NewActions && !baselineActions.Has(step.ActionType()) && !reportedActions.Has(step.ActionType()) {
+				reportedActions.Insert(step.ActionType())
+				findings = append(findings, Finding{
+					Path:          stepPath(i, j),
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("action %q is not used by the baseline", step.ActionType()),
+					Severity:      SeverityWarning,
+					RuleID:        "baseline-new-action",
+				})
+			}
+		}
+	}
+
+	if policy.RemovedSteps {
+		for _, rg := range baseline.ResourceGroups {
+			for _, step := range rg.Steps {
+				if !currentSteps.Has(types.StepDependency{ResourceGroup: rg.Name, Step: step.StepName()}) {
+					findings = append(findings, Finding{
+						Message:  fmt.Sprintf("step %s/%s from the baseline was removed", rg.Name, step.StepName()),
+						Severity: SeverityWarning,
+						RuleID:   "baseline-removed-step",
+					})
+				}
+			}
+		}
+	}
+	return findings
+}
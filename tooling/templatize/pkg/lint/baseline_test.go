@@ -0,0 +1,86 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+func TestValidateAgainstBaseline(t *testing.T) {
+	baseline := &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+		resourceGroup("rg1", "sub1", shellStep("step1", "echo step1"), shellStep("step2", "echo step2")),
+	}}
+	current := &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+		resourceGroup("rg1", "sub1", shellStep("step1", "echo step1"), helmStep("step3", "aks")),
+		resourceGroup("rg2", "sub2", helmStep("step4", "aks"), helmStep("step5", "aks")),
+		resourceGroup("rg3", "sub2"),
+	}}
+
+	testCases := []struct {
+		name     string
+		policy   *BaselinePolicy
+		expected []Finding
+	}{
+		{
+			name: "default policy",
+			expected: []Finding{
+				{
+					Path:          "resourceGroups[0].steps[1]",
+					ResourceGroup: ptr.To(0),
+					Message:       `action "Helm" is not used by the baseline`,
+					Severity:      SeverityWarning,
+					RuleID:        "baseline-new-action",
+				},
+				{
+					Path:          "resourceGroups[1]",
+					ResourceGroup: ptr.To(1),
+					Message:       `subscription "sub2" is not deployed to by the baseline`,
+					Severity:      SeverityWarning,
+					RuleID:        "baseline-new-subscription",
+				},
+				{
+					Message:  "step rg1/step2 from the baseline was removed",
+					Severity: SeverityWarning,
+					RuleID:   "baseline-removed-step",
+				},
+			},
+		},
+		{
+			name:   "only removed steps",
+			policy: &BaselinePolicy{RemovedSteps: true},
+			expected: []Finding{{
+				Message:  "step rg1/step2 from the baseline was removed",
+				Severity: SeverityWarning,
+				RuleID:   "baseline-removed-step",
+			}},
+		},
+		{
+			name:   "nothing sensitive",
+			policy: &BaselinePolicy{},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ValidateAgainstBaseline(current, baseline, tc.policy))
+		})
+	}
+	assert.Empty(t, ValidateAgainstBaseline(baseline, baseline, nil))
+}
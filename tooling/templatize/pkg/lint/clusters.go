@@ -0,0 +1,76 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// aksCluster returns the AKS cluster a step targets, if any.
+func aksCluster(step types.Step) string {
+	switch s := step.(type) {
+	case *types.ShellStep:
+		return s.AKSCluster
+	case *types.HelmStep:
+		return s.AKSCluster
+	default:
+		return ""
+	}
+}
+
+func checkUniqueAKSClusters(p *types.Pipeline, opts *Options) []Finding {
+	if !opts.UniqueAKSClusters {
+		return nil
+	}
+	type cluster struct {
+		subscription string
+		name         string
+	}
+	owners := map[cluster]string{}
+	reported := map[cluster]sets.Set[string]{}
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			name := aksCluster(step)
+			if name == "" {
+				continue
+			}
+			key := cluster{subscription: rg.Subscription, name: name}
+			owner, seen := owners[key]
+			if !seen {
+				owners[key] = rg.Name
+				reported[key] = sets.New[string]()
+				continue
+			}
+			if owner == rg.Name || reported[key].Has(rg.Name) {
+				continue
+			}
+			reported[key].Insert(rg.Name)
+			findings = append(findings, Finding{
+				Path:          stepPath(i, j),
+				ResourceGroup: ptr.To(i),
+				Message:       fmt.Sprintf("aksCluster %q is used by multiple resource groups: %q and %q", name, owner, rg.Name),
+				Severity:      SeverityError,
+				RuleID:        "unique-aks-cluster",
+			})
+		}
+	}
+	return findings
+}
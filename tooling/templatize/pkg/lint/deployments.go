@@ -0,0 +1,76 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// deploysToResourceGroup determines whether a step runs a deployment in the Azure resource group of
+// its pipeline resource group, rather than at subscription scope.
+func deploysToResourceGroup(step types.Step) bool {
+	switch s := step.(type) {
+	case *types.ARMStep:
+		return s.DeploymentLevel != "Subscription"
+	case *types.ARMStackStep:
+		return s.DeploymentLevel != "Subscription"
+	default:
+		return false
+	}
+}
+
+// checkConcurrentDeployments warns about deployments to the same Azure resource group that are not
+// ordered by a dependency, since the executor runs them concurrently and Azure may reject or
+// interleave the conflicting operations.
+func checkConcurrentDeployments(p *types.Pipeline, _ *Options) []Finding {
+	type azureResourceGroup struct {
+		subscription  string
+		resourceGroup string
+	}
+	dependencies := stepDependencies(p)
+	deployments := map[azureResourceGroup][]types.StepDependency{}
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			if !deploysToResourceGroup(step) {
+				continue
+			}
+			key := azureResourceGroup{subscription: rg.Subscription, resourceGroup: rg.ResourceGroup}
+			current := types.StepDependency{ResourceGroup: rg.Name, Step: step.StepName()}
+			before := transitiveDependencies(current, dependencies)
+			for _, previous := range deployments[key] {
+				if _, ordered := before[previous]; ordered {
+					continue
+				}
+				if _, ordered := transitiveDependencies(previous, dependencies)[current]; ordered {
+					continue
+				}
+				findings = append(findings, Finding{
+					Path:          stepPath(i, j),
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("step %q deploys to resource group %q in subscription %q concurrently with %s/%s, add a dependency between them", step.StepName(), rg.ResourceGroup, rg.Subscription, previous.ResourceGroup, previous.Step),
+					Severity:      SeverityWarning,
+					RuleID:        "concurrent-deployments",
+				})
+			}
+			deployments[key] = append(deployments[key], current)
+		}
+	}
+	return findings
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+func armStep(name string, dependsOn ...types.StepDependency) *types.ARMStep {
+	return &types.ARMStep{
+		StepMeta:   types.StepMeta{Name: name, Action: "ARM", DependsOn: dependsOn},
+		Template:   "templates/" + name + ".bicep",
+		Parameters: "configurations/" + name + ".tmpl.bicepparam",
+	}
+}
+
+func TestCheckConcurrentDeployments(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		pipeline *types.Pipeline
+		expected []Finding
+	}{
+		{
+			name:     "unordered deployments to the same resource group",
+			pipeline: pipelineWith(armStep("step1"), armStep("step2")),
+			expected: []Finding{{
+				Path:          "resourceGroups[0].steps[1]",
+				ResourceGroup: ptr.To(0),
+				Message:       `step "step2" deploys to resource group "resourceGroup" in subscription "subscription" concurrently with rg/step1, add a dependency between them`,
+				Severity:      SeverityWarning,
+				RuleID:        "concurrent-deployments",
+			}},
+		},
+		{
+			name: "dependency on an unrelated step",
+			pipeline: pipelineWith(
+				armStep("step1"),
+				shellStep("step2", "echo step2"),
+				armStep("step3", types.StepDependency{ResourceGroup: "rg", Step: "step2"}),
+			),
+			expected: []Finding{{
+				Path:          "resourceGroups[0].steps[2]",
+				ResourceGroup: ptr.To(0),
+				Message:       `step "step3" deploys to resource group "resourceGroup" in subscription "subscription" concurrently with rg/step1, add a dependency between them`,
+				Severity:      SeverityWarning,
+				RuleID:        "concurrent-deployments",
+			}},
+		},
+		{
+			name: "deployments ordered by dependsOn",
+			pipeline: pipelineWith(
+				armStep("step1"),
+				&types.ShellStep{
+					StepMeta: types.StepMeta{Name: "step2", Action: "Shell", DependsOn: []types.StepDependency{{ResourceGroup: "rg", Step: "step1"}}},
+					Command:  "echo step2",
+				},
+				armStep("step3", types.StepDependency{ResourceGroup: "rg", Step: "step2"}),
+			),
+		},
+		{
+			name: "deployments ordered by consuming outputs",
+			pipeline: pipelineWith(
+				armStep("step1"),
+				&types.ARMStep{
+					StepMeta:   types.StepMeta{Name: "step2", Action: "ARM"},
+					Template:   "templates/step2.bicep",
+					Parameters: "configurations/step2.tmpl.bicepparam",
+					Variables: []types.Variable{{
+						Name:  "id",
+						Value: types.Value{Input: &types.Input{StepDependency: types.StepDependency{ResourceGroup: "rg", Step: "step1"}, Name: "id"}},
+					}},
+				},
+			),
+		},
+		{
+			name: "pipeline resource groups sharing an Azure resource group",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub", armStep("step1")),
+				{
+					ResourceGroupMeta: &types.ResourceGroupMeta{Name: "rg2", ResourceGroup: "rg1", Subscription: "sub"},
+					Steps:             []types.Step{armStep("step2")},
+				},
+			}},
+			expected: []Finding{{
+				Path:          "resourceGroups[1].steps[0]",
+				ResourceGroup: ptr.To(1),
+				Message:       `step "step2" deploys to resource group "rg1" in subscription "sub" concurrently with rg1/step1, add a dependency between them`,
+				Severity:      SeverityWarning,
+				RuleID:        "concurrent-deployments",
+			}},
+		},
+		{
+			name: "deployments to different resource groups",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub", armStep("step1")),
+				resourceGroup("rg2", "sub", armStep("step2")),
+			}},
+		},
+		{
+			name: "subscription-level deployment",
+			pipeline: pipelineWith(
+				armStep("step1"),
+				&types.ARMStep{
+					StepMeta:        types.StepMeta{Name: "step2", Action: "ARM"},
+					Template:        "templates/step2.bicep",
+					Parameters:      "configurations/step2.tmpl.bicepparam",
+					DeploymentLevel: "Subscription",
+				},
+			),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, checkConcurrentDeployments(tc.pipeline, nil))
+		})
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+type durationField struct {
+	path  string
+	value *string
+}
+
+// durationFields lists the duration-valued fields of a step.
+func durationFields(i, j int, step types.Step) []durationField {
+	var fields []durationField
+	if retries := step.AutomatedRetries(); retries != nil && retries.DurationBetweenRetries != "" {
+		fields = append(fields, durationField{
+			path:  stepPath(i, j) + ".automatedRetry.durationBetweenRetries",
+			value: &retries.DurationBetweenRetries,
+		})
+	}
+	if helmStep, ok := step.(*types.HelmStep); ok && helmStep.Timeout != "" {
+		fields = append(fields, durationField{
+			path:  stepPath(i, j) + ".timeout",
+			value: &helmStep.Timeout,
+		})
+	}
+	return fields
+}
+
+var durationUnits = regexp.MustCompile(`[a-z]+`)
+
+// microseconds rewrites the spellings of microseconds accepted by time.ParseDuration, using the
+// micro sign (U+00B5) or the Greek letter mu (U+03BC), to "us".
+var microseconds = strings.NewReplacer("\u00b5s", "us", "\u03bcs", "us")
+
+// unitOrder ranks duration units from finest to coarsest.
+var unitOrder = map[string]int{"ns": 0, "us": 1, "ms": 2, "s": 3, "m": 4, "h": 5}
+
+// durationUnit returns the finest unit used to express a duration, so "1h30m" is expressed in minutes.
+func durationUnit(raw string) string {
+	var unit string
+	for _, candidate := range durationUnits.FindAllString(microseconds.Replace(raw), -1) {
+		if unit == "" || unitOrder[candidate] < unitOrder[unit] {
+			unit = candidate
+		}
+	}
+	return unit
+}
+
+func checkDurationUnits(p *types.Pipeline, _ *Options) []Finding {
+	counts := map[string]int{}
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			for _, field := range durationFields(i, j, step) {
+				if _, err := time.ParseDuration(*field.value); err != nil {
+					continue
+				}
+				// "0" is the only duration without a unit and is consistent with any unit
+				if unit := durationUnit(*field.value); unit != "" {
+					counts[unit]++
+				}
+			}
+		}
+	}
+	if len(counts) < 2 {
+		return nil
+	}
+	units := sets.List(sets.KeySet(counts))
+	canonical := units[0]
+	for _, unit := range units {
+		if counts[unit] > counts[canonical] {
+			canonical = unit
+		}
+	}
+	sort.Slice(units, func(i, j int) bool {
+		return unitOrder[units[i]] < unitOrder[units[j]]
+	})
+	return []Finding{{
+		Message:  fmt.Sprintf("durations are expressed in inconsistent units (%s), consider using %q throughout", strings.Join(units, ", "), canonical),
+		Severity: SeverityWarning,
+		RuleID:   "duration-units",
+	}}
+}
+
+// NormalizeDurations rewrites every duration in the pipeline to its canonical Go representation, e.g. "90s" to "1m30s".
+func NormalizeDurations(p *types.Pipeline) error {
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			for _, field := range durationFields(i, j, step) {
+				d, err := time.ParseDuration(*field.value)
+				if err != nil {
+					return fmt.Errorf("%s: failed to parse duration %q: %w", field.path, *field.value, err)
+				}
+				*field.value = d.String()
+			}
+		}
+	}
+	return nil
+}
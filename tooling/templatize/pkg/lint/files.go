@@ -0,0 +1,199 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// fileReference is a path field of a step.
+type fileReference struct {
+	// field is the name of the field in the pipeline.
+	field string
+	// kind describes what the field references, for use in messages.
+	kind string
+	// value is the path, relative to the pipeline directory.
+	value string
+	// extensions lists the file extensions the field may reference. Any file may be referenced when empty.
+	extensions []string
+}
+
+var (
+	bicepExtensions      = []string{".bicep"}
+	bicepParamExtensions = []string{".bicepparam"}
+	valuesFileExtensions = []string{".yaml", ".yml", ".yaml.tmpl", ".yml.tmpl"}
+)
+
+// fileReferences lists the populated path fields of a step, which the executor resolves against the pipeline directory.
+func fileReferences(step types.Step) []fileReference {
+	var refs []fileReference
+	switch s := step.(type) {
+	case *types.ARMStep:
+		refs = []fileReference{
+			{field: "template", kind: "template", value: s.Template, extensions: bicepExtensions},
+			{field: "parameters", kind: "parameters file", value: s.Parameters, extensions: bicepParamExtensions},
+		}
+	case *types.ARMStackStep:
+		refs = []fileReference{
+			{field: "template", kind: "template", value: s.Template, extensions: bicepExtensions},
+			{field: "parameters", kind: "parameters file", value: s.Parameters, extensions: bicepParamExtensions},
+		}
+	case *types.HelmStep:
+		refs = []fileReference{
+			{field: "chartDir", kind: "chart directory", value: s.ChartDir},
+			{field: "valuesFile", kind: "values file", value: s.ValuesFile, extensions: valuesFileExtensions},
+		}
+		for k, file := range s.NamespaceFiles {
+			refs = append(refs, fileReference{field: fmt.Sprintf("namespaceFiles[%d]", k), kind: "namespace manifest", value: file})
+		}
+	case *types.ShellStep:
+		refs = []fileReference{
+			{field: "workingDir", kind: "working directory", value: s.WorkingDir},
+		}
+	case *types.GrafanaDashboardsStep:
+		refs = []fileReference{
+			{field: "observabilityConfig", kind: "observability config", value: s.ObservabilityConfig},
+		}
+	case *types.SecretSyncStep:
+		refs = []fileReference{
+			{field: "configurationFile", kind: "configuration file", value: s.ConfigurationFile},
+		}
+	}
+	var populated []fileReference
+	for _, ref := range refs {
+		if ref.value != "" {
+			populated = append(populated, ref)
+		}
+	}
+	return populated
+}
+
+func checkFileExtensions(p *types.Pipeline, _ *Options) []Finding {
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			for _, ref := range fileReferences(step) {
+				if len(ref.extensions) == 0 || hasAnySuffix(ref.value, ref.extensions) {
+					continue
+				}
+				findings = append(findings, Finding{
+					Path:          stepPath(i, j) + "." + ref.field,
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("step %q (%s): expected %s %s, got %s", step.StepName(), step.ActionType(), joinAlternatives(ref.extensions), ref.kind, filepath.Base(ref.value)),
+					Severity:      SeverityError,
+					RuleID:        "file-extension",
+				})
+			}
+		}
+	}
+	return findings
+}
+
+func hasAnySuffix(s string, suffixes []string) bool {
+	for _, suffix := range suffixes {
+		if strings.HasSuffix(s, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinAlternatives renders a list as "a", "a or b" or "a, b or c".
+func joinAlternatives(items []string) string {
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " or " + items[len(items)-1]
+}
+
+// PathPolicy restricts the files that steps may reference.
+type PathPolicy struct {
+	// PipelineDirectory is the directory that relative paths in the pipeline are resolved against.
+	PipelineDirectory string
+	// AllowedPaths are the directories that referenced files must reside in. Relative entries are
+	// resolved against the PipelineDirectory.
+	AllowedPaths []string
+}
+
+// resolve returns the file the executor reads for a path referenced by a step. The executor joins
+// every path to the pipeline directory, including absolute ones.
+func (p *PathPolicy) resolve(path string) string {
+	return evalSymlinks(filepath.Join(p.PipelineDirectory, path))
+}
+
+func (p *PathPolicy) resolveAllowed(path string) string {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(p.PipelineDirectory, path)
+	}
+	return evalSymlinks(filepath.Clean(path))
+}
+
+// evalSymlinks resolves the symbolic links in the longest existing prefix of path, so that a link
+// cannot point a referenced file outside of the allowed paths.
+func evalSymlinks(path string) string {
+	for current := path; ; current = filepath.Dir(current) {
+		if resolved, err := filepath.EvalSymlinks(current); err == nil {
+			rest, err := filepath.Rel(current, path)
+			if err != nil {
+				return path
+			}
+			return filepath.Join(resolved, rest)
+		}
+		if filepath.Dir(current) == current {
+			return path
+		}
+	}
+}
+
+func (p *PathPolicy) allows(path string) bool {
+	resolved := p.resolve(path)
+	for _, allowed := range p.AllowedPaths {
+		rel, err := filepath.Rel(p.resolveAllowed(allowed), resolved)
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkAllowedPaths(p *types.Pipeline, opts *Options) []Finding {
+	if opts.PathPolicy == nil {
+		return nil
+	}
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			for _, ref := range fileReferences(step) {
+				if opts.PathPolicy.allows(ref.value) {
+					continue
+				}
+				findings = append(findings, Finding{
+					Path:          stepPath(i, j) + "." + ref.field,
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("step %q: path %q is outside allowed paths", step.StepName(), ref.value),
+					Severity:      SeverityError,
+					RuleID:        "allowed-paths",
+				})
+			}
+		}
+	}
+	return findings
+}
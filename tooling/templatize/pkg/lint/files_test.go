@@ -0,0 +1,154 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+func TestCheckAllowedPaths(t *testing.T) {
+	p := pipelineWith(
+		&types.ARMStep{
+			StepMeta:   types.StepMeta{Name: "step1", Action: "ARM"},
+			Template:   "templates/main.bicep",
+			Parameters: "../configurations/main.tmpl.bicepparam",
+		},
+		&types.HelmStep{
+			StepMeta:       types.StepMeta{Name: "step2", Action: "Helm"},
+			ChartDir:       "/etc/passwd", // joined to the pipeline directory like relative paths
+			ValuesFile:     "../../outside/values.yaml",
+			NamespaceFiles: []string{"deploy/namespace.yaml", "../../etc/namespace.yaml"},
+		},
+		&types.ShellStep{
+			StepMeta:   types.StepMeta{Name: "step3", Action: "Shell"},
+			Command:    "make deploy",
+			WorkingDir: "../servicesuffix",
+		},
+		&types.GrafanaDashboardsStep{
+			StepMeta:            types.StepMeta{Name: "step4", Action: "GrafanaDashboards"},
+			ObservabilityConfig: "../../etc/passwd",
+		},
+		&types.SecretSyncStep{
+			StepMeta:          types.StepMeta{Name: "step5", Action: "SecretSync"},
+			ConfigurationFile: "../../secrets.yaml",
+		},
+	)
+
+	testCases := []struct {
+		name     string
+		policy   *PathPolicy
+		expected []Finding
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name: "multiple roots",
+			policy: &PathPolicy{
+				PipelineDirectory: "/repo/service",
+				AllowedPaths:      []string{".", "/repo/configurations"},
+			},
+			expected: []Finding{
+				{
+					Path:          "resourceGroups[0].steps[1].valuesFile",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step2": path "../../outside/values.yaml" is outside allowed paths`,
+					Severity:      SeverityError,
+					RuleID:        "allowed-paths",
+				},
+				{
+					Path:          "resourceGroups[0].steps[1].namespaceFiles[1]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step2": path "../../etc/namespace.yaml" is outside allowed paths`,
+					Severity:      SeverityError,
+					RuleID:        "allowed-paths",
+				},
+				{
+					Path:          "resourceGroups[0].steps[2].workingDir",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step3": path "../servicesuffix" is outside allowed paths`,
+					Severity:      SeverityError,
+					RuleID:        "allowed-paths",
+				},
+				{
+					Path:          "resourceGroups[0].steps[3].observabilityConfig",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step4": path "../../etc/passwd" is outside allowed paths`,
+					Severity:      SeverityError,
+					RuleID:        "allowed-paths",
+				},
+				{
+					Path:          "resourceGroups[0].steps[4].configurationFile",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step5": path "../../secrets.yaml" is outside allowed paths`,
+					Severity:      SeverityError,
+					RuleID:        "allowed-paths",
+				},
+			},
+		},
+		{
+			name: "everything allowed",
+			policy: &PathPolicy{
+				PipelineDirectory: "/repo/service",
+				AllowedPaths:      []string{"/"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, checkAllowedPaths(p, &Options{PathPolicy: tc.policy}))
+		})
+	}
+}
+
+func TestCheckAllowedPathsSymlinks(t *testing.T) {
+	root := t.TempDir()
+	pipelineDir := filepath.Join(root, "service")
+	for _, dir := range []string{filepath.Join(pipelineDir, "templates"), filepath.Join(root, "outside")} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatalf("failed to create %s: %v", dir, err)
+		}
+	}
+	if err := os.Symlink(filepath.Join(root, "outside"), filepath.Join(pipelineDir, "escape")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+	if err := os.Symlink("templates", filepath.Join(pipelineDir, "inside")); err != nil {
+		t.Fatalf("failed to create symlink: %v", err)
+	}
+
+	p := pipelineWith(
+		&types.ARMStep{
+			StepMeta:   types.StepMeta{Name: "step1", Action: "ARM"},
+			Template:   "inside/main.bicep",
+			Parameters: "escape/main.bicepparam",
+		},
+	)
+	expected := []Finding{{
+		Path:          "resourceGroups[0].steps[0].parameters",
+		ResourceGroup: ptr.To(0),
+		Message:       `step "step1": path "escape/main.bicepparam" is outside allowed paths`,
+		Severity:      SeverityError,
+		RuleID:        "allowed-paths",
+	}}
+	assert.Equal(t, expected, checkAllowedPaths(p, &Options{PathPolicy: &PathPolicy{PipelineDirectory: pipelineDir, AllowedPaths: []string{"."}}}))
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+)
+
+// Severity describes how a finding should be treated by consumers.
+type Severity string
+
+const (
+	// SeverityError marks a finding that makes the pipeline invalid.
+	SeverityError Severity = "error"
+	// SeverityWarning marks an advisory finding that does not block a rollout.
+	SeverityWarning Severity = "warning"
+	// SeverityNote marks an informational finding that the author may want to review.
+	SeverityNote Severity = "note"
+)
+
+// Finding is a single result of validating a pipeline.
+type Finding struct {
+	// Path locates the offending element in the pipeline, e.g. resourceGroups[0].steps[1].
+	// An empty path refers to the pipeline as a whole.
+	Path string `json:"path,omitempty"`
+	// ResourceGroup is the index of the resource group the finding refers to. It is unset for
+	// findings that refer to the pipeline as a whole.
+	ResourceGroup *int `json:"resourceGroup,omitempty"`
+	// Message is a human-readable description of the problem.
+	Message string `json:"message"`
+	// Severity determines how the finding should be treated.
+	Severity Severity `json:"severity"`
+	// RuleID identifies the rule that produced the finding.
+	RuleID string `json:"ruleId"`
+}
+
+func (f Finding) String() string {
+	if f.Path == "" {
+		return fmt.Sprintf("%s: %s [%s]", f.Severity, f.Message, f.RuleID)
+	}
+	return fmt.Sprintf("%s: %s: %s [%s]", f.Severity, f.Path, f.Message, f.RuleID)
+}
+
+func stepPath(i, j int) string {
+	return fmt.Sprintf("resourceGroups[%d].steps[%d]", i, j)
+}
+
+func resourceGroupPath(i int) string {
+	return fmt.Sprintf("resourceGroups[%d]", i)
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// helmReleaseNameMaxLength is the longest release name Helm accepts.
+const helmReleaseNameMaxLength = 53
+
+func checkHelmReleaseNames(p *types.Pipeline, _ *Options) []Finding {
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			helmStep, ok := step.(*types.HelmStep)
+			if !ok || helmStep.ReleaseName == "" {
+				continue
+			}
+			var problems []string
+			tooLong := len(helmStep.ReleaseName) > helmReleaseNameMaxLength
+			if tooLong {
+				problems = append(problems, validation.MaxLenError(helmReleaseNameMaxLength))
+			}
+			for _, problem := range validation.IsDNS1123Label(helmStep.ReleaseName) {
+				// the label length limit is looser than Helm's, which is already reported
+				if tooLong && problem == validation.MaxLenError(validation.DNS1123LabelMaxLength) {
+					continue
+				}
+				problems = append(problems, problem)
+			}
+			if len(problems) > 0 {
+				findings = append(findings, Finding{
+					Path:          stepPath(i, j) + ".releaseName",
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("step %q (Helm): invalid release name %q: %s", step.StepName(), helmStep.ReleaseName, strings.Join(problems, "; ")),
+					Severity:      SeverityError,
+					RuleID:        "helm-release-name",
+				})
+			}
+		}
+	}
+	return findings
+}
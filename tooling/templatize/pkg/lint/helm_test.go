@@ -0,0 +1,55 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckHelmReleaseNames(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		release string
+		valid   bool
+	}{
+		{name: "valid", release: "arohcp-frontend", valid: true},
+		{name: "longest valid", release: strings.Repeat("a", 53), valid: true},
+		{name: "too long", release: strings.Repeat("a", 54)},
+		{name: "longer than a DNS label", release: strings.Repeat("a", 64)},
+		{name: "uppercase", release: "Frontend"},
+		{name: "underscore", release: "aro_hcp"},
+		{name: "leading hyphen", release: "-frontend"},
+		{name: "trailing hyphen", release: "frontend-"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			step := helmStep("step1", "aks")
+			step.ReleaseName = tc.release
+			findings := checkHelmReleaseNames(pipelineWith(step), nil)
+			if tc.valid {
+				assert.Empty(t, findings)
+				return
+			}
+			if assert.Len(t, findings, 1) {
+				assert.Equal(t, "resourceGroups[0].steps[0].releaseName", findings[0].Path)
+				assert.Contains(t, findings[0].Message, `step "step1" (Helm): invalid release name "`+tc.release+`"`)
+				assert.Equal(t, len(tc.release) > 53, strings.Contains(findings[0].Message, "must be no more than 53 characters"))
+				assert.NotContains(t, findings[0].Message, "must be no more than 63 characters")
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// identityField is an identity field that an action may require to be populated.
+type identityField struct {
+	name  string
+	value func(rg *types.ResourceGroup, step types.Step) string
+}
+
+var (
+	subscriptionField = identityField{
+		name:  "subscription",
+		value: func(rg *types.ResourceGroup, _ types.Step) string { return rg.Subscription },
+	}
+	resourceGroupField = identityField{
+		name:  "resourceGroup",
+		value: func(rg *types.ResourceGroup, _ types.Step) string { return rg.ResourceGroup },
+	}
+	aksClusterField = identityField{
+		name:  "aksCluster",
+		value: func(_ *types.ResourceGroup, step types.Step) string { return aksCluster(step) },
+	}
+)
+
+// actionIdentityFields lists the identity fields each action needs to be populated.
+var actionIdentityFields = map[string][]identityField{
+	"ARM":      {subscriptionField},
+	"ARMStack": {subscriptionField},
+	"Helm":     {subscriptionField, resourceGroupField, aksClusterField},
+}
+
+// clusterIdentityFields are needed by any step that targets an AKS cluster, since the cluster
+// is looked up in the resource group and subscription of the step.
+var clusterIdentityFields = []identityField{subscriptionField, resourceGroupField}
+
+func requiredIdentityFields(step types.Step) []identityField {
+	fields := actionIdentityFields[step.ActionType()]
+	if aksCluster(step) == "" {
+		return fields
+	}
+	for _, field := range clusterIdentityFields {
+		var required bool
+		for _, existing := range fields {
+			required = required || existing.name == field.name
+		}
+		if !required {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func checkIdentityFields(p *types.Pipeline, _ *Options) []Finding {
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			for _, field := range requiredIdentityFields(step) {
+				if field.value(rg, step) != "" {
+					continue
+				}
+				findings = append(findings, Finding{
+					Path:          stepPath(i, j),
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("step %q (%s): missing required %s", step.StepName(), step.ActionType(), field.name),
+					Severity:      SeverityError,
+					RuleID:        "identity-fields",
+				})
+			}
+		}
+	}
+	return findings
+}
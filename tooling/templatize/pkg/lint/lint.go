@@ -0,0 +1,94 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"errors"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// DefaultMaxSteps is the default limit on the number of steps in a pipeline.
+const DefaultMaxSteps = 10000
+
+// DefaultMaxSubscriptions is the default threshold on the number of distinct subscriptions a pipeline deploys to.
+const DefaultMaxSubscriptions = 50
+
+// Options configures the checks run by Lint.
+type Options struct {
+	// Actions, when set, requires every step's action to be registered.
+	Actions *ActionRegistry
+	// DeprecatedCommands are matched against Shell step commands to warn about deprecated invocations.
+	DeprecatedCommands []DeprecatedCommand
+	// UniqueAKSClusters requires that an AKS cluster is targeted by one resource group per subscription.
+	UniqueAKSClusters bool
+	// MaxSteps limits the total number of steps in the pipeline. Zero disables the limit.
+	MaxSteps int
+	// OutputsDeclaredFirst requires steps producing outputs to be declared before the steps consuming them.
+	OutputsDeclaredFirst bool
+	// MaxSubscriptions is the number of distinct subscriptions above which a warning is raised. Zero disables the warning.
+	MaxSubscriptions int
+	// PathPolicy, when set, requires files referenced by steps to reside in one of the allowed paths.
+	PathPolicy *PathPolicy
+}
+
+// DefaultOptions returns the options used when no other configuration is provided.
+func DefaultOptions() *Options {
+	return &Options{
+		MaxSteps:         DefaultMaxSteps,
+		MaxSubscriptions: DefaultMaxSubscriptions,
+	}
+}
+
+type check func(p *types.Pipeline, opts *Options) []Finding
+
+// Lint runs every configured check against the pipeline and returns the findings.
+func Lint(p *types.Pipeline, opts *Options) []Finding {
+	if opts == nil {
+		opts = DefaultOptions()
+	}
+	var findings []Finding
+	for _, c := range []check{
+		checkResourceGroupsDeclared,
+		checkMaxSteps,
+		checkMaxSubscriptions,
+		checkReservedNameCharacters,
+		checkActions,
+		checkIdentityFields,
+		checkDeprecatedCommands,
+		checkDuplicateCommands,
+		checkUniqueAKSClusters,
+		checkOutputsDeclaredFirst,
+		checkDurationUnits,
+		checkFileExtensions,
+		checkAllowedPaths,
+		checkHelmReleaseNames,
+		checkConcurrentDeployments,
+	} {
+		findings = append(findings, c(p, opts)...)
+	}
+	return findings
+}
+
+// Error joins the error-level findings into a single error, ignoring warnings.
+func Error(findings []Finding) error {
+	var errs []error
+	for _, finding := range findings {
+		if finding.Severity == SeverityError {
+			errs = append(errs, errors.New(finding.String()))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,586 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+func pipelineWith(steps ...types.Step) *types.Pipeline {
+	return &types.Pipeline{
+		ServiceGroup: "Microsoft.Azure.ARO.HCP.Test",
+		ResourceGroups: []*types.ResourceGroup{{
+			ResourceGroupMeta: &types.ResourceGroupMeta{
+				Name:          "rg",
+				ResourceGroup: "resourceGroup",
+				Subscription:  "subscription",
+			},
+			Steps: steps,
+		}},
+	}
+}
+
+func shellStep(name, command string) *types.ShellStep {
+	return &types.ShellStep{
+		StepMeta: types.StepMeta{Name: name, Action: "Shell"},
+		Command:  command,
+	}
+}
+
+func resourceGroup(name, subscription string, steps ...types.Step) *types.ResourceGroup {
+	return &types.ResourceGroup{
+		ResourceGroupMeta: &types.ResourceGroupMeta{
+			Name:          name,
+			ResourceGroup: name,
+			Subscription:  subscription,
+		},
+		Steps: steps,
+	}
+}
+
+func helmStep(name, aksCluster string) *types.HelmStep {
+	return &types.HelmStep{
+		StepMeta:   types.StepMeta{Name: name, Action: "Helm"},
+		AKSCluster: aksCluster,
+	}
+}
+
+func consumer(name, resourceGroup, step string) *types.ShellStep {
+	s := shellStep(name, "echo "+name)
+	s.Variables = []types.Variable{{
+		Name: "INPUT",
+		Value: types.Value{Input: &types.Input{
+			StepDependency: types.StepDependency{ResourceGroup: resourceGroup, Step: step},
+			Name:           "output",
+		}},
+	}}
+	return s
+}
+
+func helmStepWithTimeout(name, timeout string) *types.HelmStep {
+	s := helmStep(name, "aks")
+	s.Timeout = timeout
+	return s
+}
+
+func TestLint(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pipeline *types.Pipeline
+		opts     *Options
+		expected []Finding
+	}{
+		{
+			name:     "no checks configured",
+			pipeline: pipelineWith(shellStep("step1", "echo hello")),
+		},
+		{
+			name:     "no resource groups",
+			pipeline: &types.Pipeline{ServiceGroup: "Microsoft.Azure.ARO.HCP.Test", ResourceGroups: []*types.ResourceGroup{}},
+			expected: []Finding{{
+				Message:  "pipeline must declare at least one resource group",
+				Severity: SeverityError,
+				RuleID:   "resource-groups-declared",
+			}},
+		},
+		{
+			name:     "too many steps",
+			pipeline: pipelineWith(shellStep("step1", "echo hello"), shellStep("step2", "echo world")),
+			opts:     &Options{MaxSteps: 1},
+			expected: []Finding{{
+				Message:  "pipeline has 2 steps, exceeds limit 1",
+				Severity: SeverityError,
+				RuleID:   "max-steps",
+			}},
+		},
+		{
+			name: "too many subscriptions",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub1"),
+				resourceGroup("rg2", "sub2"),
+				resourceGroup("rg3", "sub1"),
+			}},
+			opts: &Options{MaxSubscriptions: 1},
+			expected: []Finding{{
+				Message:  "pipeline deploys to 2 distinct subscriptions, exceeds threshold 1",
+				Severity: SeverityWarning,
+				RuleID:   "max-subscriptions",
+			}},
+		},
+		{
+			name: "reserved characters in names",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg/1", "sub", shellStep("a/b", "echo a/b"), shellStep("a:b", "echo a:b")),
+				resourceGroup("rg:2", "sub", shellStep("a/b:c", "echo a/b:c")),
+			}},
+			expected: []Finding{
+				{
+					Path:          "resourceGroups[0]",
+					ResourceGroup: ptr.To(0),
+					Message:       `resource group name "rg/1" contains reserved character '/'`,
+					Severity:      SeverityError,
+					RuleID:        "reserved-name-character",
+				},
+				{
+					Path:          "resourceGroups[0].steps[0]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step name "a/b" contains reserved character '/'`,
+					Severity:      SeverityError,
+					RuleID:        "reserved-name-character",
+				},
+				{
+					Path:          "resourceGroups[0].steps[1]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step name "a:b" contains reserved character ':'`,
+					Severity:      SeverityError,
+					RuleID:        "reserved-name-character",
+				},
+				{
+					Path:          "resourceGroups[1]",
+					ResourceGroup: ptr.To(1),
+					Message:       `resource group name "rg:2" contains reserved character ':'`,
+					Severity:      SeverityError,
+					RuleID:        "reserved-name-character",
+				},
+				{
+					Path:          "resourceGroups[1].steps[0]",
+					ResourceGroup: ptr.To(1),
+					Message:       `step name "a/b:c" contains reserved character '/'`,
+					Severity:      SeverityError,
+					RuleID:        "reserved-name-character",
+				},
+				{
+					Path:          "resourceGroups[1].steps[0]",
+					ResourceGroup: ptr.To(1),
+					Message:       `step name "a/b:c" contains reserved character ':'`,
+					Severity:      SeverityError,
+					RuleID:        "reserved-name-character",
+				},
+			},
+		},
+		{
+			name: "registered actions",
+			pipeline: pipelineWith(
+				shellStep("step1", "echo hello"),
+				&types.ARMStep{StepMeta: types.StepMeta{Name: "step2", Action: "ARM"}},
+			),
+			opts: &Options{Actions: DefaultActionRegistry()},
+		},
+		{
+			name: "unregistered action",
+			pipeline: pipelineWith(
+				shellStep("step1", "echo hello"),
+				&types.ARMStep{StepMeta: types.StepMeta{Name: "step2", Action: "Bicep"}},
+			),
+			opts: &Options{Actions: DefaultActionRegistry()},
+			expected: []Finding{{
+				Path:          "resourceGroups[0].steps[1]",
+				ResourceGroup: ptr.To(0),
+				Message:       `no executor registered for action "Bicep"`,
+				Severity:      SeverityError,
+				RuleID:        "registered-action",
+			}},
+		},
+		{
+			name: "deprecated command",
+			pipeline: pipelineWith(
+				shellStep("step1", "az aks get-credentials --name foo"),
+				shellStep("step2", "az aks show --name foo"),
+			),
+			opts: &Options{DeprecatedCommands: []DeprecatedCommand{{
+				Pattern:     regexp.MustCompile(`az aks get-credentials`),
+				Replacement: "kubelogin convert-kubeconfig",
+			}}},
+			expected: []Finding{{
+				Path:          "resourceGroups[0].steps[0]",
+				ResourceGroup: ptr.To(0),
+				Message:       `command uses deprecated invocation "az aks get-credentials", use "kubelogin convert-kubeconfig" instead`,
+				Severity:      SeverityWarning,
+				RuleID:        "deprecated-command",
+			}},
+		},
+		{
+			name: "identical commands",
+			pipeline: pipelineWith(
+				shellStep("step1", "make deploy"),
+				shellStep("step2", "make deploy"),
+				shellStep("step3", "make deploy"),
+				shellStep("step4", "make other"),
+			),
+			expected: []Finding{{
+				Path:          "resourceGroups[0]",
+				ResourceGroup: ptr.To(0),
+				Message:       "3 Shell steps have identical commands (step1, step2, step3), consider parameterizing them",
+				Severity:      SeverityNote,
+				RuleID:        "duplicate-command",
+			}},
+		},
+		{
+			name: "shared AKS cluster allowed by default",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub", helmStep("step1", "aks1")),
+				resourceGroup("rg2", "sub", helmStep("step2", "aks1")),
+			}},
+		},
+		{
+			name: "unique AKS clusters",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub", helmStep("step1", "aks1"), helmStep("step2", "aks1")),
+				resourceGroup("rg2", "sub", helmStep("step3", "aks2")),
+				resourceGroup("rg3", "other", helmStep("step4", "aks1")),
+			}},
+			opts: &Options{UniqueAKSClusters: true},
+		},
+		{
+			name: "shared AKS cluster",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub", helmStep("step1", "aks1")),
+				resourceGroup("rg2", "sub", helmStep("step2", "aks1"), &types.ShellStep{
+					StepMeta:   types.StepMeta{Name: "step3", Action: "Shell"},
+					AKSCluster: "aks1",
+				}),
+			}},
+			opts: &Options{UniqueAKSClusters: true},
+			expected: []Finding{{
+				Path:          "resourceGroups[1].steps[0]",
+				ResourceGroup: ptr.To(1),
+				Message:       `aksCluster "aks1" is used by multiple resource groups: "rg1" and "rg2"`,
+				Severity:      SeverityError,
+				RuleID:        "unique-aks-cluster",
+			}},
+		},
+		{
+			name: "outputs consumed before declaration allowed by default",
+			pipeline: pipelineWith(
+				consumer("step1", "rg", "step2"),
+				shellStep("step2", "echo step2"),
+			),
+		},
+		{
+			name: "outputs declared first",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub", shellStep("step1", "echo hello")),
+				resourceGroup("rg2", "sub", consumer("step2", "rg1", "step1"), consumer("step3", "rg2", "step2")),
+			}},
+			opts: &Options{OutputsDeclaredFirst: true},
+		},
+		{
+			name: "outputs consumed before declaration",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub", consumer("step1", "rg2", "step2")),
+				resourceGroup("rg2", "sub", shellStep("step2", "echo hello")),
+			}},
+			opts: &Options{OutputsDeclaredFirst: true},
+			expected: []Finding{{
+				Path:          "resourceGroups[0].steps[0]",
+				ResourceGroup: ptr.To(0),
+				Message:       `step "step1" consumes output of rg2/step2, which is declared after it`,
+				Severity:      SeverityError,
+				RuleID:        "outputs-declared-first",
+			}},
+		},
+		{
+			name: "Helm and identity outputs consumed before declaration",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub",
+					&types.HelmStep{
+						StepMeta:   types.StepMeta{Name: "step1", Action: "Helm"},
+						AKSCluster: "aks",
+						InputVariables: map[string]types.Input{
+							"IMAGE": {StepDependency: types.StepDependency{ResourceGroup: "rg2", Step: "step5"}, Name: "image"},
+						},
+						KustoEndpoint: &types.Input{StepDependency: types.StepDependency{ResourceGroup: "rg2", Step: "step6"}, Name: "endpoint"},
+					},
+					&types.ImageMirrorStep{
+						StepMeta:      types.StepMeta{Name: "step2", Action: "ImageMirror"},
+						ShellIdentity: types.Value{Input: &types.Input{StepDependency: types.StepDependency{ResourceGroup: "rg2", Step: "step5"}, Name: "identity"}},
+					},
+					&types.SecretSyncStep{
+						StepMeta:     types.StepMeta{Name: "step3", Action: "SecretSync"},
+						IdentityFrom: types.Input{StepDependency: types.StepDependency{ResourceGroup: "rg2", Step: "step5"}, Name: "identity"},
+					},
+					&types.ProviderFeatureRegistrationStep{
+						StepMeta:     types.StepMeta{Name: "step4", Action: "ProviderFeatureRegistration"},
+						IdentityFrom: types.Input{StepDependency: types.StepDependency{ResourceGroup: "rg2", Step: "step5"}, Name: "identity"},
+					},
+				),
+				resourceGroup("rg2", "sub", shellStep("step5", "echo step5"), shellStep("step6", "echo step6")),
+			}},
+			opts: &Options{OutputsDeclaredFirst: true},
+			expected: []Finding{
+				{
+					Path:          "resourceGroups[0].steps[0]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step1" consumes output of rg2/step5, which is declared after it`,
+					Severity:      SeverityError,
+					RuleID:        "outputs-declared-first",
+				},
+				{
+					Path:          "resourceGroups[0].steps[0]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step1" consumes output of rg2/step6, which is declared after it`,
+					Severity:      SeverityError,
+					RuleID:        "outputs-declared-first",
+				},
+				{
+					Path:          "resourceGroups[0].steps[1]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step2" consumes output of rg2/step5, which is declared after it`,
+					Severity:      SeverityError,
+					RuleID:        "outputs-declared-first",
+				},
+				{
+					Path:          "resourceGroups[0].steps[2]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step3" consumes output of rg2/step5, which is declared after it`,
+					Severity:      SeverityError,
+					RuleID:        "outputs-declared-first",
+				},
+				{
+					Path:          "resourceGroups[0].steps[3]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step4" consumes output of rg2/step5, which is declared after it`,
+					Severity:      SeverityError,
+					RuleID:        "outputs-declared-first",
+				},
+			},
+		},
+		{
+			name: "Helm identityFrom consumed before declaration",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub",
+					&types.HelmStep{
+						StepMeta:     types.StepMeta{Name: "step1", Action: "Helm"},
+						AKSCluster:   "aks",
+						IdentityFrom: types.Input{StepDependency: types.StepDependency{ResourceGroup: "rg2", Step: "step2"}, Name: "identity"},
+					},
+				),
+				resourceGroup("rg2", "sub", shellStep("step2", "echo step2")),
+			}},
+			opts: &Options{OutputsDeclaredFirst: true},
+			expected: []Finding{{
+				Path:          "resourceGroups[0].steps[0]",
+				ResourceGroup: ptr.To(0),
+				Message:       `step "step1" consumes output of rg2/step2, which is declared after it`,
+				Severity:      SeverityError,
+				RuleID:        "outputs-declared-first",
+			}},
+		},
+		{
+			name: "SetCertificateIssuer vaultBaseUrl consumed before declaration",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "sub",
+					&types.SetCertificateIssuerStep{
+						StepMeta:     types.StepMeta{Name: "step1", Action: "SetCertificateIssuer"},
+						VaultBaseUrl: types.Value{Input: &types.Input{StepDependency: types.StepDependency{ResourceGroup: "rg2", Step: "step2"}, Name: "vaultBaseUrl"}},
+						Issuer:       types.Value{Value: "OneCertV2-PublicCA"},
+					},
+				),
+				resourceGroup("rg2", "sub", shellStep("step2", "echo step2")),
+			}},
+			opts: &Options{OutputsDeclaredFirst: true},
+			expected: []Finding{{
+				Path:          "resourceGroups[0].steps[0]",
+				ResourceGroup: ptr.To(0),
+				Message:       `step "step1" consumes output of rg2/step2, which is declared after it`,
+				Severity:      SeverityError,
+				RuleID:        "outputs-declared-first",
+			}},
+		},
+		{
+			name: "inconsistent duration units",
+			pipeline: pipelineWith(
+				helmStepWithTimeout("step1", "60s"),
+				helmStepWithTimeout("step2", "1h30m"),
+				helmStepWithTimeout("step3", "10m"),
+			),
+			expected: []Finding{{
+				Message:  `durations are expressed in inconsistent units (s, m), consider using "m" throughout`,
+				Severity: SeverityWarning,
+				RuleID:   "duration-units",
+			}},
+		},
+		{
+			name: "microsecond spellings",
+			pipeline: pipelineWith(
+				helmStepWithTimeout("step1", "10us"),
+				helmStepWithTimeout("step2", "10\u00b5s"),
+				helmStepWithTimeout("step3", "10\u03bcs"),
+			),
+		},
+		{
+			name: "unit-less durations",
+			pipeline: pipelineWith(
+				helmStepWithTimeout("step1", "0"),
+				helmStepWithTimeout("step2", "60s"),
+			),
+		},
+		{
+			name: "unit-less and inconsistent duration units",
+			pipeline: pipelineWith(
+				helmStepWithTimeout("step1", "0"),
+				helmStepWithTimeout("step2", "60s"),
+				helmStepWithTimeout("step3", "90s"),
+				helmStepWithTimeout("step4", "10m"),
+			),
+			expected: []Finding{{
+				Message:  `durations are expressed in inconsistent units (s, m), consider using "s" throughout`,
+				Severity: SeverityWarning,
+				RuleID:   "duration-units",
+			}},
+		},
+		{
+			name: "expected file extensions",
+			pipeline: pipelineWith(
+				&types.ARMStep{
+					StepMeta:   types.StepMeta{Name: "step1", Action: "ARM"},
+					Template:   "templates/main.bicep",
+					Parameters: "configurations/main.tmpl.bicepparam",
+				},
+				&types.HelmStep{
+					StepMeta:   types.StepMeta{Name: "step2", Action: "Helm"},
+					AKSCluster: "aks",
+					ValuesFile: "deploy/values.yaml.tmpl",
+				},
+			),
+		},
+		{
+			name: "unexpected file extensions",
+			pipeline: pipelineWith(
+				&types.ARMStep{
+					StepMeta:   types.StepMeta{Name: "step1", Action: "ARM"},
+					Template:   "templates/params.bicepparam",
+					Parameters: "configurations/params.json",
+				},
+				&types.HelmStep{
+					StepMeta:   types.StepMeta{Name: "step2", Action: "Helm"},
+					AKSCluster: "aks",
+					ValuesFile: "deploy/main.bicep",
+				},
+			),
+			expected: []Finding{
+				{
+					Path:          "resourceGroups[0].steps[0].template",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step1" (ARM): expected .bicep template, got params.bicepparam`,
+					Severity:      SeverityError,
+					RuleID:        "file-extension",
+				},
+				{
+					Path:          "resourceGroups[0].steps[0].parameters",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step1" (ARM): expected .bicepparam parameters file, got params.json`,
+					Severity:      SeverityError,
+					RuleID:        "file-extension",
+				},
+				{
+					Path:          "resourceGroups[0].steps[1].valuesFile",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step2" (Helm): expected .yaml, .yml, .yaml.tmpl or .yml.tmpl values file, got main.bicep`,
+					Severity:      SeverityError,
+					RuleID:        "file-extension",
+				},
+			},
+		},
+		{
+			name: "missing identity fields",
+			pipeline: &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+				resourceGroup("rg1", "",
+					&types.ARMStep{StepMeta: types.StepMeta{Name: "step1", Action: "ARM"}},
+					helmStep("step2", ""),
+					shellStep("step3", "echo step3"),
+				),
+				{
+					ResourceGroupMeta: &types.ResourceGroupMeta{Name: "rg2", Subscription: "sub"},
+					Steps: []types.Step{&types.ShellStep{
+						StepMeta:   types.StepMeta{Name: "step4", Action: "Shell"},
+						AKSCluster: "aks",
+					}},
+				},
+			}},
+			expected: []Finding{
+				{
+					Path:          "resourceGroups[0].steps[0]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step1" (ARM): missing required subscription`,
+					Severity:      SeverityError,
+					RuleID:        "identity-fields",
+				},
+				{
+					Path:          "resourceGroups[0].steps[1]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step2" (Helm): missing required subscription`,
+					Severity:      SeverityError,
+					RuleID:        "identity-fields",
+				},
+				{
+					Path:          "resourceGroups[0].steps[1]",
+					ResourceGroup: ptr.To(0),
+					Message:       `step "step2" (Helm): missing required aksCluster`,
+					Severity:      SeverityError,
+					RuleID:        "identity-fields",
+				},
+				{
+					Path:          "resourceGroups[1].steps[0]",
+					ResourceGroup: ptr.To(1),
+					Message:       `step "step4" (Shell): missing required resourceGroup`,
+					Severity:      SeverityError,
+					RuleID:        "identity-fields",
+				},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Lint(tc.pipeline, tc.opts))
+		})
+	}
+}
+
+func TestActionRegistry(t *testing.T) {
+	registry := NewActionRegistry("Shell")
+	assert.False(t, registry.Has("ARM"))
+	registry.Register("ARM")
+	assert.True(t, registry.Has("ARM"))
+	assert.Equal(t, []string{"ARM", "Shell"}, registry.Actions())
+}
+
+func TestError(t *testing.T) {
+	assert.NoError(t, Error([]Finding{{Message: "odd", Severity: SeverityWarning, RuleID: "rule"}}))
+	assert.EqualError(t, Error([]Finding{
+		{Path: "resourceGroups[0]", Message: "bad", Severity: SeverityError, RuleID: "rule"},
+		{Message: "odd", Severity: SeverityWarning, RuleID: "rule"},
+	}), "error: resourceGroups[0]: bad [rule]")
+}
+
+func TestNormalizeDurations(t *testing.T) {
+	p := pipelineWith(helmStepWithTimeout("step1", "90s"), helmStepWithTimeout("step2", "0.5h"), helmStep("step3", "aks"))
+	assert.NoError(t, NormalizeDurations(p))
+	var timeouts []string
+	for _, step := range p.ResourceGroups[0].Steps {
+		timeouts = append(timeouts, step.(*types.HelmStep).Timeout)
+	}
+	assert.Equal(t, []string{"1m30s", "30m0s", ""}, timeouts)
+	assert.Empty(t, Lint(p, nil))
+
+	assert.EqualError(t, NormalizeDurations(pipelineWith(helmStepWithTimeout("step1", "soon"))), `resourceGroups[0].steps[0].timeout: failed to parse duration "soon": time: invalid duration "soon"`)
+}
@@ -0,0 +1,108 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// inputs lists the step outputs a step consumes.
+func inputs(step types.Step) []types.Input {
+	// a step whose type does not match its action cannot be inspected, and is reported when validating
+	values, _ := StepValues(step)
+	var out []types.Input
+	for _, value := range values {
+		if value.Value.Input != nil && value.Value.Input.Step != "" {
+			out = append(out, *value.Value.Input)
+		}
+	}
+	return out
+}
+
+// stepDependencies maps every step of the pipeline to the steps it waits for, either because it
+// depends on them or because it consumes their outputs.
+func stepDependencies(p *types.Pipeline) map[types.StepDependency][]types.StepDependency {
+	dependencies := map[types.StepDependency][]types.StepDependency{}
+	for _, rg := range p.ResourceGroups {
+		for _, step := range rg.Steps {
+			key := types.StepDependency{ResourceGroup: rg.Name, Step: step.StepName()}
+			dependencies[key] = append(dependencies[key], step.Dependencies()...)
+			for _, input := range inputs(step) {
+				dependencies[key] = append(dependencies[key], input.StepDependency)
+			}
+		}
+	}
+	return dependencies
+}
+
+// transitiveDependencies lists the steps of the pipeline that must complete before step can run.
+func transitiveDependencies(step types.StepDependency, dependencies map[types.StepDependency][]types.StepDependency) map[types.StepDependency]struct{} {
+	seen := map[types.StepDependency]struct{}{}
+	queue := []types.StepDependency{step}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dependency := range dependencies[current] {
+			if _, ok := dependencies[dependency]; !ok {
+				// not a step of this pipeline
+				continue
+			}
+			if _, ok := seen[dependency]; ok || dependency == step {
+				continue
+			}
+			seen[dependency] = struct{}{}
+			queue = append(queue, dependency)
+		}
+	}
+	return seen
+}
+
+func checkOutputsDeclaredFirst(p *types.Pipeline, opts *Options) []Finding {
+	if !opts.OutputsDeclaredFirst {
+		return nil
+	}
+	declared := map[types.StepDependency]int{}
+	var position int
+	for _, rg := range p.ResourceGroups {
+		for _, step := range rg.Steps {
+			declared[types.StepDependency{ResourceGroup: rg.Name, Step: step.StepName()}] = position
+			position++
+		}
+	}
+
+	var findings []Finding
+	position = 0
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			for _, input := range inputs(step) {
+				if producer, ok := declared[input.StepDependency]; ok && producer > position {
+					findings = append(findings, Finding{
+						Path:          stepPath(i, j),
+						ResourceGroup: ptr.To(i),
+						Message:       fmt.Sprintf("step %q consumes output of %s/%s, which is declared after it", step.StepName(), input.ResourceGroup, input.Step),
+						Severity:      SeverityError,
+						RuleID:        "outputs-declared-first",
+					})
+				}
+			}
+			position++
+		}
+	}
+	return findings
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+func checkResourceGroupsDeclared(p *types.Pipeline, _ *Options) []Finding {
+	if len(p.ResourceGroups) > 0 {
+		return nil
+	}
+	return []Finding{{
+		Message:  "pipeline must declare at least one resource group",
+		Severity: SeverityError,
+		RuleID:   "resource-groups-declared",
+	}}
+}
+
+func checkMaxSteps(p *types.Pipeline, opts *Options) []Finding {
+	if opts.MaxSteps <= 0 {
+		return nil
+	}
+	var steps int
+	for _, rg := range p.ResourceGroups {
+		steps += len(rg.Steps)
+	}
+	if steps <= opts.MaxSteps {
+		return nil
+	}
+	return []Finding{{
+		Message:  fmt.Sprintf("pipeline has %d steps, exceeds limit %d", steps, opts.MaxSteps),
+		Severity: SeverityError,
+		RuleID:   "max-steps",
+	}}
+}
+
+// reservedNameCharacters may not appear in resource group or step names: '/' separates the segments of
+// step identifiers and ':' is reserved for qualifying dependencies.
+var reservedNameCharacters = []rune{'/', ':'}
+
+func checkReservedNameCharacters(p *types.Pipeline, _ *Options) []Finding {
+	var findings []Finding
+	check := func(i int, path, kind, name string) {
+		for _, reserved := range reservedNameCharacters {
+			if strings.ContainsRune(name, reserved) {
+				findings = append(findings, Finding{
+					Path:          path,
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("%s name %q contains reserved character %q", kind, name, reserved),
+					Severity:      SeverityError,
+					RuleID:        "reserved-name-character",
+				})
+			}
+		}
+	}
+	for i, rg := range p.ResourceGroups {
+		check(i, resourceGroupPath(i), "resource group", rg.Name)
+		for j, step := range rg.Steps {
+			check(i, stepPath(i, j), "step", step.StepName())
+		}
+	}
+	return findings
+}
+
+func checkMaxSubscriptions(p *types.Pipeline, opts *Options) []Finding {
+	if opts.MaxSubscriptions <= 0 {
+		return nil
+	}
+	subscriptions := sets.New[string]()
+	for _, rg := range p.ResourceGroups {
+		subscriptions.Insert(rg.Subscription)
+	}
+	if subscriptions.Len() <= opts.MaxSubscriptions {
+		return nil
+	}
+	return []Finding{{
+		Message:  fmt.Sprintf("pipeline deploys to %d distinct subscriptions, exceeds threshold %d", subscriptions.Len(), opts.MaxSubscriptions),
+		Severity: SeverityWarning,
+		RuleID:   "max-subscriptions",
+	}}
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// PipelineLevel groups findings that refer to the pipeline as a whole rather than a resource group.
+const PipelineLevel = "pipeline-level"
+
+// FindingGroup holds the findings for one resource group.
+type FindingGroup struct {
+	// ResourceGroup is the name of the resource group in the pipeline, or PipelineLevel.
+	ResourceGroup string `json:"resourceGroup"`
+	// Findings are the findings for the resource group, in the order they were reported.
+	Findings []Finding `json:"findings"`
+}
+
+// GroupByResourceGroup groups findings by the pipeline resource group they refer to. Pipeline-level
+// findings come first, followed by resource groups in the order they are declared in the pipeline.
+func GroupByResourceGroup(p *types.Pipeline, findings []Finding) []FindingGroup {
+	byGroup := map[string][]Finding{}
+	for _, finding := range findings {
+		group := PipelineLevel
+		if i := finding.ResourceGroup; i != nil && *i >= 0 && *i < len(p.ResourceGroups) {
+			group = p.ResourceGroups[*i].Name
+		}
+		byGroup[group] = append(byGroup[group], finding)
+	}
+
+	var groups []FindingGroup
+	for _, name := range append([]string{PipelineLevel}, resourceGroupNames(p)...) {
+		if groupFindings, ok := byGroup[name]; ok {
+			groups = append(groups, FindingGroup{ResourceGroup: name, Findings: groupFindings})
+			delete(byGroup, name)
+		}
+	}
+	return groups
+}
+
+func resourceGroupNames(p *types.Pipeline) []string {
+	var names []string
+	for _, rg := range p.ResourceGroups {
+		names = append(names, rg.Name)
+	}
+	return names
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+func TestGroupByResourceGroup(t *testing.T) {
+	p := &types.Pipeline{ResourceGroups: []*types.ResourceGroup{
+		resourceGroup("rg1", "sub"),
+		resourceGroup("rg2", "sub"),
+		resourceGroup("rg3", "sub"),
+	}}
+	step := Finding{Path: "resourceGroups[1].steps[0]", ResourceGroup: ptr.To(1), Message: "step", Severity: SeverityError, RuleID: "rule"}
+	group := Finding{Path: "resourceGroups[1]", ResourceGroup: ptr.To(1), Message: "group", Severity: SeverityNote, RuleID: "rule"}
+	first := Finding{Path: "resourceGroups[0].steps[3]", ResourceGroup: ptr.To(0), Message: "first", Severity: SeverityWarning, RuleID: "rule"}
+	global := Finding{Message: "global", Severity: SeverityError, RuleID: "rule"}
+
+	assert.Equal(t, []FindingGroup{
+		{ResourceGroup: PipelineLevel, Findings: []Finding{global}},
+		{ResourceGroup: "rg1", Findings: []Finding{first}},
+		{ResourceGroup: "rg2", Findings: []Finding{step, group}},
+	}, GroupByResourceGroup(p, []Finding{step, group, global, first}))
+	assert.Empty(t, GroupByResourceGroup(p, nil))
+}
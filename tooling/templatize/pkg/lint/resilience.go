@@ -0,0 +1,66 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// ResilienceReport summarizes the failure-handling posture of a pipeline.
+type ResilienceReport struct {
+	// Steps is the number of steps in the pipeline.
+	Steps int `json:"steps"`
+	// StepsWithRetries is the number of steps that declare automated retries.
+	StepsWithRetries int `json:"stepsWithRetries"`
+	// StepsWithTimeouts is the number of steps that declare a timeout.
+	StepsWithTimeouts int `json:"stepsWithTimeouts"`
+	// MaxSkippedOnFailure bounds the fraction of the pipeline's steps that are skipped when a
+	// single step fails, averaged over all steps. It is a worst case: steps that are neither
+	// dependencies nor dependents of the failing step may have completed before it failed.
+	MaxSkippedOnFailure float64 `json:"maxSkippedOnFailure"`
+}
+
+// Resilience computes the ResilienceReport of a pipeline. Steps cannot continue on error, and
+// the first failure cancels every step that is queued or running, so the only steps certain
+// to have completed when a step fails are its transitive dependencies. Every other step is
+// counted as skipped, which gives an upper bound on what a failure costs.
+func Resilience(p *types.Pipeline) ResilienceReport {
+	var report ResilienceReport
+	var steps []types.StepDependency
+	for _, rg := range p.ResourceGroups {
+		for _, step := range rg.Steps {
+			report.Steps++
+			if retry := step.AutomatedRetries(); retry != nil && retry.MaximumRetryCount > 0 {
+				report.StepsWithRetries++
+			}
+			if helm, ok := step.(*types.HelmStep); ok && helm.Timeout != "" {
+				report.StepsWithTimeouts++
+			}
+
+			steps = append(steps, types.StepDependency{ResourceGroup: rg.Name, Step: step.StepName()})
+		}
+	}
+	if report.Steps == 0 {
+		return report
+	}
+
+	dependencies := stepDependencies(p)
+	var skipped int
+	for _, step := range steps {
+		skipped += report.Steps - 1 - len(transitiveDependencies(step, dependencies))
+	}
+	report.MaxSkippedOnFailure = float64(skipped) / float64(report.Steps*report.Steps)
+	return report
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+func TestResilience(t *testing.T) {
+	testCases := []struct {
+		name     string
+		pipeline *types.Pipeline
+		expected ResilienceReport
+	}{
+		{
+			name:     "empty pipeline",
+			pipeline: &types.Pipeline{},
+		},
+		{
+			name: "independent steps",
+			pipeline: pipelineWith(
+				shellStep("step1", "echo step1"),
+				helmStepWithTimeout("step2", "5m"),
+			),
+			// a failure of either step skips the other
+			expected: ResilienceReport{Steps: 2, StepsWithTimeouts: 1, MaxSkippedOnFailure: 2.0 / 4},
+		},
+		{
+			name: "chain of steps",
+			pipeline: pipelineWith(
+				&types.ShellStep{
+					StepMeta: types.StepMeta{
+						Name:           "step1",
+						Action:         "Shell",
+						AutomatedRetry: &types.AutomatedRetry{MaximumRetryCount: 3, DurationBetweenRetries: "10s"},
+					},
+					Command: "echo step1",
+				},
+				&types.ShellStep{
+					StepMeta: types.StepMeta{
+						Name:      "step2",
+						Action:    "Shell",
+						DependsOn: []types.StepDependency{{ResourceGroup: "rg", Step: "step1"}},
+					},
+					Command: "echo step2",
+				},
+				consumer("step3", "rg", "step2"),
+				shellStep("step4", "echo step4"),
+			),
+			// only the dependencies of a failing step have completed, so a failure of step1 or
+			// step4 skips three steps, a failure of step2 skips two and a failure of step3 one
+			expected: ResilienceReport{Steps: 4, StepsWithRetries: 1, MaxSkippedOnFailure: 9.0 / 16},
+		},
+		{
+			name: "Helm step consuming outputs",
+			pipeline: pipelineWith(
+				shellStep("step1", "echo step1"),
+				&types.HelmStep{
+					StepMeta:   types.StepMeta{Name: "step2", Action: "Helm"},
+					AKSCluster: "aks",
+					InputVariables: map[string]types.Input{
+						"IMAGE": {StepDependency: types.StepDependency{ResourceGroup: "rg", Step: "step1"}, Name: "image"},
+					},
+				},
+			),
+			// a failure of step1 skips step2, while step1 has completed when step2 fails
+			expected: ResilienceReport{Steps: 2, MaxSkippedOnFailure: 1.0 / 4},
+		},
+		{
+			name: "Helm step consuming an identity",
+			pipeline: pipelineWith(
+				shellStep("step1", "echo step1"),
+				&types.HelmStep{
+					StepMeta:     types.StepMeta{Name: "step2", Action: "Helm"},
+					AKSCluster:   "aks",
+					IdentityFrom: types.Input{StepDependency: types.StepDependency{ResourceGroup: "rg", Step: "step1"}, Name: "identity"},
+				},
+			),
+			// identityFrom orders step2 after step1 just like an input variable does
+			expected: ResilienceReport{Steps: 2, MaxSkippedOnFailure: 1.0 / 4},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Resilience(tc.pipeline))
+		})
+	}
+}
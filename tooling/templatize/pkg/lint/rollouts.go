@@ -0,0 +1,85 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	configtypes "github.com/Azure/ARO-Tools/config/types"
+	"github.com/Azure/ARO-Tools/pipelines/topology"
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// LoadPipelinesFromTopology loads the pipeline of every service in the topology, keyed by file path.
+func LoadPipelinesFromTopology(t *topology.CombinedTopology, cfg configtypes.Configuration) (map[string]*types.Pipeline, error) {
+	pipelines := map[string]*types.Pipeline{}
+	for _, service := range t.Services {
+		if err := loadServicePipelines(t, service, cfg, pipelines); err != nil {
+			return nil, err
+		}
+	}
+	return pipelines, nil
+}
+
+func loadServicePipelines(t *topology.CombinedTopology, service topology.Service, cfg configtypes.Configuration, pipelines map[string]*types.Pipeline) error {
+	topologyDir, err := t.GetTopologyDirForServiceGroup(service.ServiceGroup)
+	if err != nil {
+		return fmt.Errorf("failed to get topology dir for service group %s: %w", service.ServiceGroup, err)
+	}
+	pipelineFile := filepath.Join(topologyDir, service.PipelinePath)
+	p, err := types.NewPipelineFromFile(pipelineFile, cfg)
+	if err != nil {
+		return fmt.Errorf("failed to load pipeline %s for service group %s: %w", pipelineFile, service.ServiceGroup, err)
+	}
+	pipelines[pipelineFile] = p
+
+	for _, child := range service.Children {
+		if err := loadServicePipelines(t, child, cfg, pipelines); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UniqueRolloutNames reports rollout names that are shared by more than one of the pipelines, keyed by file path.
+func UniqueRolloutNames(pipelines map[string]*types.Pipeline) []Finding {
+	filesByRollout := map[string][]string{}
+	for path, p := range pipelines {
+		filesByRollout[p.RolloutName] = append(filesByRollout[p.RolloutName], path)
+	}
+
+	var rollouts []string
+	for rollout, files := range filesByRollout {
+		if len(files) > 1 {
+			rollouts = append(rollouts, rollout)
+		}
+	}
+	sort.Strings(rollouts)
+
+	var findings []Finding
+	for _, rollout := range rollouts {
+		files := filesByRollout[rollout]
+		sort.Strings(files)
+		findings = append(findings, Finding{
+			Message:  fmt.Sprintf("rolloutName %q is used by multiple pipelines: %s", rollout, strings.Join(files, ", ")),
+			Severity: SeverityError,
+			RuleID:   "unique-rollout-name",
+		})
+	}
+	return findings
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	configtypes "github.com/Azure/ARO-Tools/config/types"
+	"github.com/Azure/ARO-Tools/pipelines/topology"
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+func TestLoadPipelinesFromTopology(t *testing.T) {
+	topo, err := topology.LoadCombined([]string{filepath.Join("testdata", "topology", "topology.yaml")})
+	if err != nil {
+		t.Fatalf("failed to load topology: %v", err)
+	}
+	topologyDir, err := topo.GetTopologyDirForServiceGroup("Microsoft.Azure.ARO.HCP.Lint.Parent")
+	if err != nil {
+		t.Fatalf("failed to get topology dir: %v", err)
+	}
+
+	pipelines, err := LoadPipelinesFromTopology(topo, configtypes.Configuration{})
+	if err != nil {
+		t.Fatalf("failed to load pipelines: %v", err)
+	}
+	parentFile := filepath.Join(topologyDir, "parent", "pipeline.yaml")
+	childFile := filepath.Join(topologyDir, "parent", "child", "svc-pipeline.yaml")
+	serviceGroups := map[string]string{}
+	for path, p := range pipelines {
+		serviceGroups[path] = p.ServiceGroup
+	}
+	// pipelines that are not part of the topology, like unlisted/pipeline.yaml, are not loaded
+	assert.Equal(t, map[string]string{
+		parentFile: "Microsoft.Azure.ARO.HCP.Lint.Parent",
+		childFile:  "Microsoft.Azure.ARO.HCP.Lint.Child",
+	}, serviceGroups)
+
+	findings := UniqueRolloutNames(pipelines)
+	if len(findings) != 1 {
+		t.Fatalf("expected one finding, got %v", findings)
+	}
+	assert.Equal(t, `rolloutName "Lint Rollout" is used by multiple pipelines: `+childFile+", "+parentFile, findings[0].Message)
+}
+
+func TestUniqueRolloutNames(t *testing.T) {
+	assert.Empty(t, UniqueRolloutNames(map[string]*types.Pipeline{
+		"a/pipeline.yaml": {RolloutName: "A Rollout"},
+		"b/pipeline.yaml": {RolloutName: "B Rollout"},
+	}))
+	assert.Equal(t, []Finding{{
+		Message:  `rolloutName "A Rollout" is used by multiple pipelines: a/pipeline.yaml, c/pipeline.yaml`,
+		Severity: SeverityError,
+		RuleID:   "unique-rollout-name",
+	}}, UniqueRolloutNames(map[string]*types.Pipeline{
+		"c/pipeline.yaml": {RolloutName: "A Rollout"},
+		"a/pipeline.yaml": {RolloutName: "A Rollout"},
+		"b/pipeline.yaml": {RolloutName: "B Rollout"},
+	}))
+}
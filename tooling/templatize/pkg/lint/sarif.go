@@ -0,0 +1,161 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// The below types are directly marshalled into JSON. They correspond to the SARIF 2.1.0
+// schema, but only contain the fields needed to surface pipeline validation results in
+// code-scanning dashboards. For the specification see
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+const (
+	sarifSchema   = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion  = "2.1.0"
+	sarifToolName = "templatize"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+// SARIF renders the findings for each pipeline file as a SARIF 2.1.0 document. The pipeline
+// file is recorded as the physical location of each of its results, while the path of each
+// finding is recorded as its logical location. Findings keyed by an empty file have no
+// physical location.
+func SARIF(findings map[string][]Finding) ([]byte, error) {
+	files := make([]string, 0, len(findings))
+	for file := range findings {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	rules := map[string]struct{}{}
+	results := []sarifResult{}
+	for _, pipelineFile := range files {
+		for _, finding := range findings[pipelineFile] {
+			level, err := sarifLevel(finding.Severity)
+			if err != nil {
+				return nil, err
+			}
+			rules[finding.RuleID] = struct{}{}
+
+			location := sarifLocation{}
+			if pipelineFile != "" {
+				location.PhysicalLocation = &sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: pipelineFile},
+				}
+			}
+			if finding.Path != "" {
+				location.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: finding.Path}}
+			}
+			result := sarifResult{
+				RuleID:  finding.RuleID,
+				Level:   level,
+				Message: sarifMessage{Text: finding.Message},
+			}
+			if location.PhysicalLocation != nil || len(location.LogicalLocations) > 0 {
+				result.Locations = []sarifLocation{location}
+			}
+			results = append(results, result)
+		}
+	}
+
+	driver := sarifDriver{Name: sarifToolName}
+	for id := range rules {
+		driver.Rules = append(driver.Rules, sarifRule{ID: id})
+	}
+	sort.Slice(driver.Rules, func(i, j int) bool {
+		return driver.Rules[i].ID < driver.Rules[j].ID
+	})
+
+	out, err := json.MarshalIndent(sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: driver},
+			Results: results,
+		}},
+	}, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal SARIF: %w", err)
+	}
+	return out, nil
+}
+
+func sarifLevel(severity Severity) (string, error) {
+	switch severity {
+	case SeverityError:
+		return "error", nil
+	case SeverityWarning:
+		return "warning", nil
+	case SeverityNote:
+		return "note", nil
+	default:
+		return "", fmt.Errorf("unknown finding severity %q", severity)
+	}
+}
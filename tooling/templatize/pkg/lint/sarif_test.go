@@ -0,0 +1,126 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSARIF(t *testing.T) {
+	out, err := SARIF(map[string][]Finding{
+		"pipeline.yaml": {
+			{Path: "resourceGroups[0].steps[1]", Message: "something is wrong", Severity: SeverityError, RuleID: "b-rule"},
+			{Message: "something is odd", Severity: SeverityWarning, RuleID: "a-rule"},
+			{Message: "something to consider", Severity: SeverityNote, RuleID: "a-rule"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to render SARIF: %v", err)
+	}
+	assert.JSONEq(t, `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [{
+    "tool": {"driver": {"name": "templatize", "rules": [{"id": "a-rule"}, {"id": "b-rule"}]}},
+    "results": [
+      {
+        "ruleId": "b-rule",
+        "level": "error",
+        "message": {"text": "something is wrong"},
+        "locations": [{
+          "physicalLocation": {"artifactLocation": {"uri": "pipeline.yaml"}},
+          "logicalLocations": [{"fullyQualifiedName": "resourceGroups[0].steps[1]"}]
+        }]
+      },
+      {
+        "ruleId": "a-rule",
+        "level": "warning",
+        "message": {"text": "something is odd"},
+        "locations": [{
+          "physicalLocation": {"artifactLocation": {"uri": "pipeline.yaml"}}
+        }]
+      },
+      {
+        "ruleId": "a-rule",
+        "level": "note",
+        "message": {"text": "something to consider"},
+        "locations": [{
+          "physicalLocation": {"artifactLocation": {"uri": "pipeline.yaml"}}
+        }]
+      }
+    ]
+  }]
+}`, string(out))
+}
+
+func TestSARIFMultiplePipelines(t *testing.T) {
+	out, err := SARIF(map[string][]Finding{
+		"b/pipeline.yaml": {{Message: "something is wrong", Severity: SeverityError, RuleID: "rule"}},
+		"a/pipeline.yaml": {{Message: "something is odd", Severity: SeverityWarning, RuleID: "rule"}},
+		"":                {{Message: "something spans pipelines", Severity: SeverityError, RuleID: "rule"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to render SARIF: %v", err)
+	}
+	assert.JSONEq(t, `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [{
+    "tool": {"driver": {"name": "templatize", "rules": [{"id": "rule"}]}},
+    "results": [
+      {
+        "ruleId": "rule",
+        "level": "error",
+        "message": {"text": "something spans pipelines"}
+      },
+      {
+        "ruleId": "rule",
+        "level": "warning",
+        "message": {"text": "something is odd"},
+        "locations": [{
+          "physicalLocation": {"artifactLocation": {"uri": "a/pipeline.yaml"}}
+        }]
+      },
+      {
+        "ruleId": "rule",
+        "level": "error",
+        "message": {"text": "something is wrong"},
+        "locations": [{
+          "physicalLocation": {"artifactLocation": {"uri": "b/pipeline.yaml"}}
+        }]
+      }
+    ]
+  }]
+}`, string(out))
+}
+
+func TestSARIFEmpty(t *testing.T) {
+	out, err := SARIF(nil)
+	if err != nil {
+		t.Fatalf("failed to render SARIF: %v", err)
+	}
+	assert.JSONEq(t, `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [{"tool": {"driver": {"name": "templatize"}}, "results": []}]
+}`, string(out))
+}
+
+func TestSARIFUnknownSeverity(t *testing.T) {
+	_, err := SARIF(map[string][]Finding{"pipeline.yaml": {{Message: "huh", Severity: "fatal", RuleID: "rule"}}})
+	assert.EqualError(t, err, `unknown finding severity "fatal"`)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// DeprecatedCommand describes a deprecated CLI invocation and what should be used in its place.
+type DeprecatedCommand struct {
+	// Pattern matches the deprecated invocation in a Shell step's command.
+	Pattern *regexp.Regexp
+	// Replacement describes the invocation to use instead.
+	Replacement string
+}
+
+func checkDeprecatedCommands(p *types.Pipeline, opts *Options) []Finding {
+	if len(opts.DeprecatedCommands) == 0 {
+		return nil
+	}
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		for j, step := range rg.Steps {
+			shellStep, ok := step.(*types.ShellStep)
+			if !ok {
+				continue
+			}
+			for _, deprecated := range opts.DeprecatedCommands {
+				if match := deprecated.Pattern.FindString(shellStep.Command); match != "" {
+					findings = append(findings, Finding{
+						Path:          stepPath(i, j),
+						ResourceGroup: ptr.To(i),
+						Message:       fmt.Sprintf("command uses deprecated invocation %q, use %q instead", match, deprecated.Replacement),
+						Severity:      SeverityWarning,
+						RuleID:        "deprecated-command",
+					})
+				}
+			}
+		}
+	}
+	return findings
+}
+
+func checkDuplicateCommands(p *types.Pipeline, _ *Options) []Finding {
+	var findings []Finding
+	for i, rg := range p.ResourceGroups {
+		var commands []string
+		stepsByCommand := map[string][]string{}
+		for _, step := range rg.Steps {
+			shellStep, ok := step.(*types.ShellStep)
+			if !ok || shellStep.Command == "" {
+				continue
+			}
+			if _, seen := stepsByCommand[shellStep.Command]; !seen {
+				commands = append(commands, shellStep.Command)
+			}
+			stepsByCommand[shellStep.Command] = append(stepsByCommand[shellStep.Command], shellStep.StepName())
+		}
+		for _, command := range commands {
+			if steps := stepsByCommand[command]; len(steps) > 1 {
+				findings = append(findings, Finding{
+					Path:          resourceGroupPath(i),
+					ResourceGroup: ptr.To(i),
+					Message:       fmt.Sprintf("%d Shell steps have identical commands (%s), consider parameterizing them", len(steps), strings.Join(steps, ", ")),
+					Severity:      SeverityNote,
+					RuleID:        "duplicate-command",
+				})
+			}
+		}
+	}
+	return findings
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 Microsoft Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lint
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/Azure/ARO-Tools/pipelines/types"
+)
+
+// StepValue is a value read by a step, along with the field of the step it is read from.
+type StepValue struct {
+	// Field is the path of the value relative to the step, like "variables[0]".
+	Field string
+	Value types.Value
+}
+
+// StepValues lists the values a step reads from the configuration or from the outputs of other steps.
+// Optional fields are only listed when they are set.
+func StepValues(step types.Step) ([]StepValue, error) {
+	var values []StepValue
+	add := func(field string, value types.Value) {
+		values = append(values, StepValue{Field: field, Value: value})
+	}
+	addOptionalInput := func(field string, input types.Input) {
+		if input.Name != "" || input.Step != "" {
+			add(field, types.Value{Input: &input})
+		}
+	}
+
+	switch step.ActionType() {
+	case "Shell":
+		specificStep, ok := step.(*types.ShellStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.ShellStep, but got %T", step.ActionType(), step)
+		}
+		for k, variable := range specificStep.Variables {
+			add(fmt.Sprintf("variables[%d]", k), variable.Value)
+		}
+		add("shellIdentity", specificStep.ShellIdentity)
+	case "ARM":
+		specificStep, ok := step.(*types.ARMStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.ARMStep, but got %T", step.ActionType(), step)
+		}
+		for k, variable := range specificStep.Variables {
+			add(fmt.Sprintf("variables[%d]", k), variable.Value)
+		}
+	case "ARMStack":
+		specificStep, ok := step.(*types.ARMStackStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.ARMStackStep, but got %T", step.ActionType(), step)
+		}
+		for k, variable := range specificStep.Variables {
+			add(fmt.Sprintf("variables[%d]", k), variable.Value)
+		}
+	case "Helm":
+		specificStep, ok := step.(*types.HelmStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.HelmStep, but got %T", step.ActionType(), step)
+		}
+		for _, name := range sets.List(sets.KeySet(specificStep.InputVariables)) {
+			input := specificStep.InputVariables[name]
+			add(fmt.Sprintf("inputVariables[%s]", name), types.Value{Input: &input})
+		}
+		if specificStep.KustoEndpoint != nil {
+			addOptionalInput("kustoEndpoint", *specificStep.KustoEndpoint)
+		}
+		addOptionalInput("identityFrom", specificStep.IdentityFrom)
+	case "DelegateChildZone":
+		specificStep, ok := step.(*types.DelegateChildZoneStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.DelegateChildZoneStep, but got %T", step.ActionType(), step)
+		}
+		add("parentZone", specificStep.ParentZone)
+		add("childZone", specificStep.ChildZone)
+	case "SetCertificateIssuer":
+		specificStep, ok := step.(*types.SetCertificateIssuerStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.SetCertificateIssuerStep, but got %T", step.ActionType(), step)
+		}
+		add("vaultBaseUrl", specificStep.VaultBaseUrl)
+		add("issuer", specificStep.Issuer)
+	case "CreateCertificate":
+		specificStep, ok := step.(*types.CreateCertificateStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.CreateCertificateStep, but got %T", step.ActionType(), step)
+		}
+		add("vaultBaseUrl", specificStep.VaultBaseUrl)
+		add("certificateName", specificStep.CertificateName)
+		add("contentType", specificStep.ContentType)
+		add("san", specificStep.SAN)
+		add("issuer", specificStep.Issuer)
+	case "ResourceProviderRegistration":
+		specificStep, ok := step.(*types.ResourceProviderRegistrationStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.ResourceProviderRegistrationStep, but got %T", step.ActionType(), step)
+		}
+		add("resourceProviderNamespaces", specificStep.ResourceProviderNamespaces)
+	case "ImageMirror":
+		specificStep, ok := step.(*types.ImageMirrorStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.ImageMirrorStep, but got %T", step.ActionType(), step)
+		}
+		add("targetACR", specificStep.TargetACR)
+		add("sourceRegistry", specificStep.SourceRegistry)
+		add("repository", specificStep.Repository)
+		add("digest", specificStep.Digest)
+		add("pullSecretKeyVault", specificStep.PullSecretKeyVault)
+		add("pullSecretName", specificStep.PullSecretName)
+		add("shellIdentity", specificStep.ShellIdentity)
+	case "RPLogsAccount", "ClusterLogsAccount":
+		specificStep, ok := step.(*types.LogsStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.LogsStep, but got %T", step.ActionType(), step)
+		}
+		add("subscriptionId", specificStep.SubscriptionId)
+		add("namespace", specificStep.Namespace)
+		add("certsan", specificStep.CertSAN)
+		add("certdescription", specificStep.CertDescription)
+		add("configVersion", specificStep.ConfigVersion)
+	case "ProviderFeatureRegistration":
+		specificStep, ok := step.(*types.ProviderFeatureRegistrationStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.ProviderFeatureRegistrationStep, but got %T", step.ActionType(), step)
+		}
+		add("providerConfigRef", types.Value{ConfigRef: specificStep.ProviderConfigRef})
+		add("identityFrom", types.Value{Input: &specificStep.IdentityFrom})
+	case "SecretSync":
+		specificStep, ok := step.(*types.SecretSyncStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.SecretSyncStep, but got %T", step.ActionType(), step)
+		}
+		add("identityFrom", types.Value{Input: &specificStep.IdentityFrom})
+	case "GrafanaDashboards":
+		specificStep, ok := step.(*types.GrafanaDashboardsStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.GrafanaDashboardsStep, but got %T", step.ActionType(), step)
+		}
+		addOptionalInput("identityFrom", specificStep.IdentityFrom)
+	case "GrafanaDatasources":
+		specificStep, ok := step.(*types.GrafanaDatasourcesStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.GrafanaDatasourcesStep, but got %T", step.ActionType(), step)
+		}
+		addOptionalInput("identityFrom", specificStep.IdentityFrom)
+	case "ProwJob":
+		specificStep, ok := step.(*types.ProwJobStep)
+		if !ok {
+			return nil, fmt.Errorf("have action %q, expected *types.ProwJobStep, but got %T", step.ActionType(), step)
+		}
+		addOptionalInput("identityFrom", specificStep.IdentityFrom)
+	}
+	return values, nil
+}
@@ -234,6 +234,9 @@ func precheckResourceGroups(ctx context.Context, logger logr.Logger, executionGr
 	return nil
 }
 
+// RunPipeline executes the steps of a pipeline as their dependencies complete. Loading a pipeline preserves
+// the declaration order of its resource groups and steps, and the execution graph is derived from that order,
+// so steps whose dependencies complete together are always queued in the same order.
 func RunPipeline(service *topology.Service, pipeline *types.Pipeline, ctx context.Context, options *PipelineRunOptions, executor Executor) (Outputs, error) {
 	logger, err := logr.FromContext(ctx)
 	if err != nil {
@@ -758,12 +761,62 @@ func shouldRetryError(logger logr.Logger, step types.Step, err error) bool {
 	return false
 }
 
-func RunStep(id graph.Identifier, s types.Step, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
-	logger := logr.FromContextOrDiscard(ctx)
-	logger.Info("Running step.", "description", s.Description())
+// stepRunner executes a step of a specific action.
+type stepRunner func(id graph.Identifier, s types.Step, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error)
+
+// runnerFor adapts a function running steps of type T to a stepRunner.
+func runnerFor[T types.Step](run func(id graph.Identifier, step T, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error)) stepRunner {
+	return func(id graph.Identifier, s types.Step, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		step, ok := s.(T)
+		if !ok {
+			var expected T
+			return nil, nil, fmt.Errorf("have action %q, expected %T, but got %T", s.ActionType(), expected, s)
+		}
+		return run(id, step, ctx, executionTarget, options, state)
+	}
+}
+
+// stepRunners implements the step actions executed by RunStep, steps with any other action are skipped.
+var stepRunners = map[string]stepRunner{
+	"ARM": runnerFor(func(id graph.Identifier, step *types.ARMStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		a, err := newArmClient(executionTarget.GetSubscriptionID(), executionTarget.GetRegion(), options.BicepClient)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create ARM clients: %w", err)
+		}
+		output, details, err := a.runArmStep(ctx, options, executionTarget.GetResourceGroup(), id, step, state)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to run ARM step: %w", err)
+		}
+		return output, details, nil
+	}),
+	"ARMStack": runnerFor(func(id graph.Identifier, step *types.ARMStackStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		output, details, err := runArmStackStep(ctx, options, executionTarget, id, step, state, options.Environment, options.Stamp)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to run ARM step: %w", err)
+		}
+		return output, details, nil
+	}),
+	"GrafanaDashboards": runnerFor(func(id graph.Identifier, step *types.GrafanaDashboardsStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		if err := runGrafanaDashboardsStep(id, step, ctx, options, executionTarget, state); err != nil {
+			return nil, nil, fmt.Errorf("error running Grafana Dashboard Upsert Step, %v", err)
+		}
+		return nil, nil, nil
+	}),
+	"GrafanaDatasources": runnerFor(func(id graph.Identifier, step *types.GrafanaDatasourcesStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		if err := runGrafanaDatasourcesStep(id, step, ctx, options, executionTarget, state); err != nil {
+			return nil, nil, fmt.Errorf("error running Grafana Datasources Step, %v", err)
+		}
+		return nil, nil, nil
+	}),
+	"Helm": runnerFor(func(id graph.Identifier, step *types.HelmStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		if err := runHelmStep(id, step, ctx, options, executionTarget, state); err != nil {
+			return nil, nil, fmt.Errorf("error running Helm release deployment Step, %v", err)
+		}
+		return nil, nil, nil
+	}),
+	"ImageMirror": runnerFor(func(id graph.Identifier, step *types.ImageMirrorStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		logger := logr.FromContextOrDiscard(ctx)
 
-	switch step := s.(type) {
-	case *types.ImageMirrorStep:
 		var buf bytes.Buffer
 
 		if step.CopyFrom == "oci-layout" {
@@ -778,7 +831,28 @@ func RunStep(id graph.Identifier, s types.Step, ctx context.Context, executionTa
 		output := buf.String()
 		fmt.Println(output)
 		return ShellOutput(output), nil, nil
-	case *types.ShellStep:
+	}),
+	"ProviderFeatureRegistration": runnerFor(func(id graph.Identifier, step *types.ProviderFeatureRegistrationStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		if err := runRegistrationStep(step, ctx, options, executionTarget); err != nil {
+			return nil, nil, fmt.Errorf("error running provider and feature registration Step, %v", err)
+		}
+		return nil, nil, nil
+	}),
+	"ProwJob": runnerFor(func(id graph.Identifier, step *types.ProwJobStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		if err := runProwJobStep(step, ctx, options, executionTarget); err != nil {
+			return nil, nil, fmt.Errorf("error running Prow Job Step, %v", err)
+		}
+		return nil, nil, nil
+	}),
+	"SecretSync": runnerFor(func(id graph.Identifier, step *types.SecretSyncStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		if err := runSecretSyncStep(step, ctx, options); err != nil {
+			return nil, nil, fmt.Errorf("error running secret sync Step, %v", err)
+		}
+		return nil, nil, nil
+	}),
+	"Shell": runnerFor(func(id graph.Identifier, step *types.ShellStep, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+		logger := logr.FromContextOrDiscard(ctx)
+
 		var buf bytes.Buffer
 
 		kubeconfigFile, err := KubeConfig(ctx, executionTarget.GetSubscriptionID(), executionTarget.GetResourceGroup(), step.AKSCluster)
@@ -804,56 +878,24 @@ func RunStep(id graph.Identifier, s types.Step, ctx context.Context, executionTa
 		output := buf.String()
 		fmt.Println(output)
 		return ShellOutput(output), nil, nil
-	case *types.SecretSyncStep:
-		if err := runSecretSyncStep(step, ctx, options); err != nil {
-			return nil, nil, fmt.Errorf("error running secret sync Step, %v", err)
-		}
-		return nil, nil, nil
-	case *types.ProviderFeatureRegistrationStep:
-		if err := runRegistrationStep(step, ctx, options, executionTarget); err != nil {
-			return nil, nil, fmt.Errorf("error running provider and feature registration Step, %v", err)
-		}
-		return nil, nil, nil
-	case *types.HelmStep:
-		if err := runHelmStep(id, step, ctx, options, executionTarget, state); err != nil {
-			return nil, nil, fmt.Errorf("error running Helm release deployment Step, %v", err)
-		}
-		return nil, nil, nil
-	case *types.ProwJobStep:
-		if err := runProwJobStep(step, ctx, options, executionTarget); err != nil {
-			return nil, nil, fmt.Errorf("error running Prow Job Step, %v", err)
-		}
-		return nil, nil, nil
-	case *types.GrafanaDashboardsStep:
-		if err := runGrafanaDashboardsStep(id, step, ctx, options, executionTarget, state); err != nil {
-			return nil, nil, fmt.Errorf("error running Grafana Dashboard Upsert Step, %v", err)
-		}
-		return nil, nil, nil
-	case *types.GrafanaDatasourcesStep:
-		if err := runGrafanaDatasourcesStep(id, step, ctx, options, executionTarget, state); err != nil {
-			return nil, nil, fmt.Errorf("error running Grafana Datasources Step, %v", err)
-		}
-		return nil, nil, nil
-	case *types.ARMStep:
-		a, err := newArmClient(executionTarget.GetSubscriptionID(), executionTarget.GetRegion(), options.BicepClient)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to create ARM clients: %w", err)
-		}
-		output, details, err := a.runArmStep(ctx, options, executionTarget.GetResourceGroup(), id, step, state)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to run ARM step: %w", err)
-		}
-		return output, details, nil
-	case *types.ARMStackStep:
-		output, details, err := runArmStackStep(ctx, options, executionTarget, id, step, state, options.Environment, options.Stamp)
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to run ARM step: %w", err)
-		}
-		return output, details, nil
-	default:
+	}),
+}
+
+// RunnableActions lists the step actions executed by RunStep, steps with any other action are skipped.
+func RunnableActions() []string {
+	return sets.List(sets.KeySet(stepRunners))
+}
+
+func RunStep(id graph.Identifier, s types.Step, ctx context.Context, executionTarget ExecutionTarget, options *StepRunOptions, state *ExecutionState) (Output, DetailsProducer, error) {
+	logger := logr.FromContextOrDiscard(ctx)
+	logger.Info("Running step.", "description", s.Description())
+
+	run, ok := stepRunners[s.ActionType()]
+	if !ok {
 		logger.Info("No implementation for action type - skip", "actionType", s.ActionType())
 		return nil, nil, nil
 	}
+	return run(id, s, ctx, executionTarget, options, state)
 }
 
 func getInputValues(serviceGroup string, configuredVariables []types.Variable, cfg configtypes.Configuration, inputs Outputs) (map[string]any, error) {
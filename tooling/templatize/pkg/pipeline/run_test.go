@@ -17,6 +17,8 @@ package pipeline
 import (
 	"context"
 	"fmt"
+	"os"
+	"path/filepath"
 	"slices"
 	"sync"
 	"testing"
@@ -329,6 +331,118 @@ func TestMockedPipelineRunError(t *testing.T) {
 	}
 }
 
+// TestPipelineLoadIsDeterministic guarantees that loading the same pipeline repeatedly yields the steps in
+// declaration order and an identical execution graph, so that step queueing never depends on map iteration.
+func TestPipelineLoadIsDeterministic(t *testing.T) {
+	pipelineFile := filepath.Join(t.TempDir(), "pipeline.yaml")
+	if err := os.WriteFile(pipelineFile, []byte(`$schema: pipeline.schema.v1
+serviceGroup: Microsoft.Azure.ARO.HCP.Test
+rolloutName: Test Rollout
+resourceGroups:
+- name: zulu
+  resourceGroup: zulu
+  subscription: test
+  steps:
+  - name: second
+    action: Shell
+    command: echo second
+    dependsOn:
+    - resourceGroup: alpha
+      step: first
+  - name: third
+    action: Shell
+    command: echo third
+- name: alpha
+  resourceGroup: alpha
+  subscription: test
+  steps:
+  - name: first
+    action: Shell
+    command: echo first
+  - name: fourth
+    action: Shell
+    command: echo fourth
+    dependsOn:
+    - resourceGroup: zulu
+      step: third
+    - resourceGroup: zulu
+      step: second
+`), 0644); err != nil {
+		t.Fatalf("failed to write pipeline: %v", err)
+	}
+
+	load := func() ([]types.StepDependency, []string) {
+		p, err := types.NewPipelineFromFile(pipelineFile, configtypes.Configuration{})
+		if err != nil {
+			t.Fatalf("failed to load pipeline: %v", err)
+		}
+		var steps []types.StepDependency
+		for _, rg := range p.ResourceGroups {
+			for _, step := range rg.Steps {
+				steps = append(steps, types.StepDependency{ResourceGroup: rg.Name, Step: step.StepName()})
+			}
+		}
+		executionGraph, err := graph.ForPipeline(&topology.Service{ServiceGroup: p.ServiceGroup}, p)
+		if err != nil {
+			t.Fatalf("failed to generate execution graph: %v", err)
+		}
+		var nodes []string
+		for _, node := range executionGraph.Nodes {
+			nodes = append(nodes, fmt.Sprintf("%v <- %v", node.Identifier, node.Parents))
+		}
+		return steps, nodes
+	}
+
+	steps, nodes := load()
+	if diff := cmp.Diff(steps, []types.StepDependency{
+		{ResourceGroup: "zulu", Step: "second"},
+		{ResourceGroup: "zulu", Step: "third"},
+		{ResourceGroup: "alpha", Step: "first"},
+		{ResourceGroup: "alpha", Step: "fourth"},
+	}); len(diff) != 0 {
+		t.Fatalf("steps not loaded in declaration order: %s", diff)
+	}
+	for i := 0; i < 10; i++ {
+		reloadedSteps, reloadedNodes := load()
+		if diff := cmp.Diff(steps, reloadedSteps); len(diff) != 0 {
+			t.Errorf("step order changed between loads: %s", diff)
+		}
+		if diff := cmp.Diff(nodes, reloadedNodes); len(diff) != 0 {
+			t.Errorf("execution graph changed between loads: %s", diff)
+		}
+	}
+}
+
+func TestRunStepDispatch(t *testing.T) {
+	testCases := []struct {
+		name        string
+		step        types.Step
+		expectedErr string
+	}{
+		{
+			name: "action without implementation is skipped",
+			step: &types.ShellStep{StepMeta: types.StepMeta{Name: "step", Action: "DelegateChildZone"}},
+		},
+		{
+			name:        "step type not matching action",
+			step:        &types.ShellStep{StepMeta: types.StepMeta{Name: "step", Action: "Helm"}},
+			expectedErr: `have action "Helm", expected *types.HelmStep, but got *types.ShellStep`,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			output, details, err := RunStep(graph.Identifier{}, tc.step, logr.NewContext(t.Context(), testr.New(t)), nil, nil, nil)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Nil(t, output)
+			assert.Nil(t, details)
+		})
+	}
+}
+
 func TestPipelineRun(t *testing.T) {
 	pipeline := &types.Pipeline{
 		ServiceGroup: "Microsoft.Azure.ARO.HCP.Test",